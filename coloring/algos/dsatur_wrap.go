@@ -9,7 +9,7 @@ import (
 func FourColor(adj [][]int) []int {
 	n := len(adj)
 
-	g := graphs.NewBasicGraph()
+	g := graphs.NewBasicGraphWithPolicy(graphs.SimplePolicy)
 	names := make([]string, n)
 	for i := 0; i < n; i++ {
 		names[i] = strconv.Itoa(i)
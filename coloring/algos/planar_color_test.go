@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/Salvatore112/graph_analysis_algorithms/graphs"
+	"github.com/Salvatore112/graph_analysis_algorithms/graphs/generators"
 )
 
 func makeK4() *graphs.BasicGraph {
@@ -23,7 +24,7 @@ func TestFiveColor_K4(t *testing.T) {
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
-	for v, neis := range g.Vertices {
+	for v, neis := range g.AdjacencyLists() {
 		for _, u := range neis {
 			if colors[v] == colors[u] {
 				t.Fatalf("adjacent %s and %s share color %d", v, u, colors[v])
@@ -46,3 +47,32 @@ func TestFourColor_K4(t *testing.T) {
 		t.Fatalf("expected to use 4 colors on K4, used %d", len(used))
 	}
 }
+
+func TestStoreColors(t *testing.T) {
+	g := makeK4()
+	colors, err := FiveColorPlanar(g)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	StoreColors(g, colors)
+	for v := range g.AllVertices() {
+		if c, ok := graphs.VertexAttr(g.Attrs(), v, ColorKey); !ok || c != colors[v] {
+			t.Fatalf("expected color %d stored on %s, got %d (%v)", colors[v], v, c, ok)
+		}
+	}
+}
+
+func TestFiveColor_RandomTriangulation(t *testing.T) {
+	for seed := range uint64(5) {
+		g := generators.RandomPlanarTriangulation(60, seed).Basic()
+		colors, err := FiveColorPlanar(g)
+		if err != nil {
+			t.Fatalf("seed %d: unexpected error: %v", seed, err)
+		}
+		for u, v := range g.Edges() {
+			if colors[u] == colors[v] || colors[u] >= 5 {
+				t.Fatalf("seed %d: bad colors %d and %d on edge %s-%s", seed, colors[u], colors[v], u, v)
+			}
+		}
+	}
+}
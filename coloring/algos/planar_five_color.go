@@ -7,8 +7,8 @@ import (
 	"github.com/Salvatore112/graph_analysis_algorithms/graphs"
 )
 
-func FiveColorPlanar(g *graphs.BasicGraph) (map[string]int, error) {
-	adj := cloneAdj(g.Vertices)
+func FiveColorPlanar(g graphs.Graph) (map[string]int, error) {
+	adj := cloneAdj(g)
 	order := make([]removalRecord, 0, len(adj))
 
 	for len(adj) > 0 {
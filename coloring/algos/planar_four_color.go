@@ -8,8 +8,8 @@ import (
 	"github.com/Salvatore112/graph_analysis_algorithms/graphs"
 )
 
-func FourColorPlanar(g *graphs.BasicGraph) (map[string]int, error) {
-	adj := cloneAdj(g.Vertices)
+func FourColorPlanar(g graphs.Graph) (map[string]int, error) {
+	adj := cloneAdj(g)
 	verts := make([]string, 0, len(adj))
 	for v := range adj {
 		verts = append(verts, v)
@@ -1,14 +1,16 @@
 package algos
 
+import "github.com/Salvatore112/graph_analysis_algorithms/graphs"
+
 type Adj map[string]map[string]struct{}
 
-func cloneAdj(m map[string][]string) Adj {
-	res := make(Adj, len(m))
-	for v, lst := range m {
+func cloneAdj(g graphs.Graph) Adj {
+	res := make(Adj)
+	for v := range g.AllVertices() {
 		if res[v] == nil {
 			res[v] = map[string]struct{}{}
 		}
-		for _, u := range lst {
+		for u := range g.Neighbors(v) {
 			if res[u] == nil {
 				res[u] = map[string]struct{}{}
 			}
@@ -21,3 +23,12 @@ func cloneAdj(m map[string][]string) Adj {
 	}
 	return res
 }
+
+// ColorKey is the vertex attribute StoreColors writes colors to.
+var ColorKey = graphs.NewKey[int]("color")
+
+// StoreColors saves a coloring returned by FiveColorPlanar or FourColorPlanar
+// in the ColorKey attribute of the vertices of g.
+func StoreColors(g graphs.Attributed, colors map[string]int) {
+	graphs.SetVertexAttrs(g.Attrs(), ColorKey, colors)
+}
@@ -2,6 +2,10 @@ package gedecomp
 
 import (
 	"container/list"
+	"slices"
+	"strconv"
+
+	"github.com/Salvatore112/graph_analysis_algorithms/graphs"
 )
 
 // Graph представляет неориентированный граф.
@@ -28,6 +32,61 @@ func (g *Graph) AddEdge(u, v int) {
 	g.adj[v] = append(g.adj[v], u)
 }
 
+// withoutVertex возвращает граф, в котором у вершины v нет рёбер.
+// Списки смежности, не содержащие v, общие с исходным графом, поэтому
+// копируются только списки соседей v.
+func (g *Graph) withoutVertex(v int) *Graph {
+	adj := slices.Clone(g.adj)
+	adj[v] = []int{}
+	for _, u := range g.adj[v] {
+		if u == v || !slices.Contains(adj[u], v) {
+			continue
+		}
+		adj[u] = slices.DeleteFunc(slices.Clone(adj[u]), func(w int) bool { return w == v })
+	}
+	return &Graph{n: g.n, adj: adj}
+}
+
+// FromGraph строит граф по любому графу из пакета graphs. Вершины
+// нумеруются в порядке сортировки имён, имена возвращаются вторым
+// значением. Ориентированные дуги становятся неориентированными рёбрами.
+func FromGraph(g graphs.Graph) (*Graph, []string) {
+	names := g.SortedVertices()
+	ids := make(map[string]int, len(names))
+	for i, v := range names {
+		ids[v] = i
+	}
+	res := NewGraph(len(names))
+	for u, v := range graphs.SortedPairs(g.Edges()) {
+		res.AddEdge(ids[u], ids[v])
+	}
+	return res, names
+}
+
+// ToBasicGraph переводит граф в graphs.BasicGraph; вершина i получает имя
+// strconv.Itoa(i).
+func (g *Graph) ToBasicGraph() *graphs.BasicGraph {
+	res := graphs.NewBasicGraph()
+	for u := 0; u < g.n; u++ {
+		res.AddVertex(strconv.Itoa(u))
+	}
+	for u := 0; u < g.n; u++ {
+		loops := 0
+		for _, v := range g.adj[u] {
+			switch {
+			case u < v:
+				res.AddEdge(strconv.Itoa(u), strconv.Itoa(v))
+			case u == v:
+				// петля записана в adj[u] дважды
+				if loops++; loops%2 == 0 {
+					res.AddEdge(strconv.Itoa(u), strconv.Itoa(v))
+				}
+			}
+		}
+	}
+	return res
+}
+
 // Blossom реализует алгоритм Эдмондса (blossom) для поиска максимального паросочетания.
 type Blossom struct {
 	n       int
@@ -217,18 +276,7 @@ func gallaiEdmondsDecomposition(g *Graph) (map[int]bool, map[int]bool, map[int]b
 		if D[v] {
 			continue
 		}
-		tempGraph := NewGraph(n)
-		for u := 0; u < n; u++ {
-			if u == v {
-				continue
-			}
-			for _, w := range g.adj[u] {
-				if w == v || u >= w {
-					continue
-				}
-				tempGraph.AddEdge(u, w)
-			}
-		}
+		tempGraph := g.withoutVertex(v)
 		tempMatch := maximumMatching(tempGraph)
 		tempSize := 0
 		for _, x := range tempMatch {
@@ -127,3 +127,43 @@ func TestComplexGraph(t *testing.T) {
 		t.Errorf("TestComplexGraph: Expected C = %v, got %v", expectedC, C)
 	}
 }
+
+// TestBasicGraphConversion проверяет перевод графа в graphs.BasicGraph и обратно.
+func TestBasicGraphConversion(t *testing.T) {
+	g := graphFromEdges(4, [][2]int{{0, 1}, {1, 2}, {2, 0}, {3, 3}})
+
+	basic := g.ToBasicGraph()
+	if basic.VertexCount() != 4 || basic.EdgeCount() != 4 {
+		t.Fatalf("Expected 4 vertices and 4 edges, got %d and %d", basic.VertexCount(), basic.EdgeCount())
+	}
+	if !basic.HasEdge("2", "0") || basic.Degree("3") != 2 {
+		t.Errorf("Expected edge 2-0 and a single self-loop at 3, got %v", basic.AdjacencyLists())
+	}
+
+	back, names := FromGraph(basic)
+	if !reflect.DeepEqual(names, []string{"0", "1", "2", "3"}) {
+		t.Errorf("Expected names [0 1 2 3], got %v", names)
+	}
+	if size := edmondsMaximumMatchingSize(back); size != edmondsMaximumMatchingSize(g) {
+		t.Errorf("Expected the same matching size after round trip, got %d", size)
+	}
+	if !back.ToBasicGraph().Equal(basic) {
+		t.Errorf("Expected round trip to keep the graph, diff %+v", basic.Diff(back.ToBasicGraph()))
+	}
+}
+
+// TestWithoutVertex проверяет, что удаление вершины не меняет исходный граф.
+func TestWithoutVertex(t *testing.T) {
+	g := graphFromEdges(4, [][2]int{{0, 1}, {1, 2}, {2, 3}, {1, 3}})
+
+	h := g.withoutVertex(1)
+	if len(h.adj[1]) != 0 || !reflect.DeepEqual(h.adj[3], []int{2}) {
+		t.Errorf("Expected no edges at 1, got %v", h.adj)
+	}
+	if !reflect.DeepEqual(g.adj[3], []int{2, 1}) {
+		t.Errorf("Expected the original graph to be unchanged, got %v", g.adj)
+	}
+	if size := edmondsMaximumMatchingSize(h); size != 1 {
+		t.Errorf("Expected matching size 1, got %d", size)
+	}
+}
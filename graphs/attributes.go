@@ -0,0 +1,327 @@
+package graphs
+
+import (
+	"cmp"
+	"encoding/json"
+	"iter"
+	"maps"
+	"slices"
+)
+
+// Key names an attribute whose values have type T.
+//
+// Keys with the same name refer to the same attribute; reading a value
+// through a key of another type reports it as missing.
+type Key[T any] struct {
+	name string
+}
+
+func NewKey[T any](name string) Key[T] {
+	return Key[T]{name}
+}
+
+func (k Key[T]) Name() string {
+	return k.name
+}
+
+// AttributesOf stores named properties of the vertices and edges of a graph
+// with vertices of type N.
+//
+// An edge attribute belongs to the pair of vertices, so parallel edges share
+// it, and for undirected graphs (u, v) and (v, u) name the same edge. Values
+// are stored as given: Clone copies the maps, not the values themselves.
+type AttributesOf[N Vertex] struct {
+	directed bool
+	vertex   map[N]map[string]any
+	edge     map[N]map[N]map[string]any
+}
+
+// Attributes holds the attributes of a graph with string vertex IDs.
+type Attributes = AttributesOf[string]
+
+// Attributed is implemented by graphs that carry attributes.
+type Attributed interface {
+	Attrs() *Attributes
+}
+
+// AttributedGraph is a Graph with attributes.
+type AttributedGraph interface {
+	Graph
+	Attributed
+}
+
+func newAttributes[N Vertex](directed bool) *AttributesOf[N] {
+	return &AttributesOf[N]{
+		directed: directed,
+		vertex:   make(map[N]map[string]any),
+		edge:     make(map[N]map[N]map[string]any),
+	}
+}
+
+// storedAttrs is implemented by the graphs of this package. It returns the
+// attributes without creating them, so nil if none were ever set.
+type storedAttrs interface {
+	storedAttrs() *Attributes
+}
+
+// readAttrs returns the attributes of g for reading, or nil if g has none.
+// Unlike Attrs it never modifies g, so concurrent readers do not race.
+func readAttrs(g Graph) *Attributes {
+	switch a := g.(type) {
+	case storedAttrs:
+		return a.storedAttrs()
+	case Attributed:
+		return a.Attrs()
+	}
+	return nil
+}
+
+// lazyAttrs creates *attrs on first use; graphs keep a nil pointer until an
+// attribute is set so that graphs built as struct literals keep working.
+func lazyAttrs[N Vertex](attrs **AttributesOf[N], directed bool) *AttributesOf[N] {
+	if *attrs == nil {
+		*attrs = newAttributes[N](directed)
+	}
+	return *attrs
+}
+
+func (a *AttributesOf[N]) edgeProps(u, v N, create bool) map[string]any {
+	if a == nil && !create {
+		return nil
+	}
+	if props := a.edge[u][v]; props != nil || !create {
+		return props
+	}
+	props := make(map[string]any)
+	if a.edge[u] == nil {
+		a.edge[u] = make(map[N]map[string]any)
+	}
+	a.edge[u][v] = props
+	if !a.directed {
+		if a.edge[v] == nil {
+			a.edge[v] = make(map[N]map[string]any)
+		}
+		a.edge[v][u] = props
+	}
+	return props
+}
+
+func (a *AttributesOf[N]) vertexProps(v N, create bool) map[string]any {
+	if a == nil && !create {
+		return nil
+	}
+	props := a.vertex[v]
+	if props == nil && create {
+		props = make(map[string]any)
+		a.vertex[v] = props
+	}
+	return props
+}
+
+// lookup returns the value of k in props, decoding it first if it was
+// read from JSON. The decoded value is not stored back, so lookups never
+// modify props and may run concurrently.
+func lookup[T any](props map[string]any, k Key[T]) (T, bool) {
+	var zero T
+	value, exists := props[k.name]
+	if !exists {
+		return zero, false
+	}
+	if raw, ok := value.(json.RawMessage); ok {
+		var decoded T
+		if err := json.Unmarshal(raw, &decoded); err != nil {
+			return zero, false
+		}
+		return decoded, true
+	}
+	typed, ok := value.(T)
+	return typed, ok
+}
+
+func SetVertexAttr[N Vertex, T any](a *AttributesOf[N], v N, k Key[T], value T) {
+	a.vertexProps(v, true)[k.name] = value
+}
+
+func VertexAttr[N Vertex, T any](a *AttributesOf[N], v N, k Key[T]) (T, bool) {
+	return lookup(a.vertexProps(v, false), k)
+}
+
+// SetVertexAttrs sets k for every vertex of values, e.g. to store a coloring.
+func SetVertexAttrs[N Vertex, T any](a *AttributesOf[N], k Key[T], values map[N]T) {
+	for v, value := range values {
+		SetVertexAttr(a, v, k, value)
+	}
+}
+
+// VertexAttrs collects the values of k of all vertices that have one.
+func VertexAttrs[N Vertex, T any](a *AttributesOf[N], k Key[T]) map[N]T {
+	res := make(map[N]T)
+	for v, props := range a.vertex {
+		if value, ok := lookup(props, k); ok {
+			res[v] = value
+		}
+	}
+	return res
+}
+
+func SetEdgeAttr[N Vertex, T any](a *AttributesOf[N], u, v N, k Key[T], value T) {
+	a.edgeProps(u, v, true)[k.name] = value
+}
+
+func EdgeAttr[N Vertex, T any](a *AttributesOf[N], u, v N, k Key[T]) (T, bool) {
+	return lookup(a.edgeProps(u, v, false), k)
+}
+
+// DeleteVertexAttr removes the attribute name of v.
+func (a *AttributesOf[N]) DeleteVertexAttr(v N, name string) {
+	delete(a.vertex[v], name)
+}
+
+// DeleteEdgeAttr removes the attribute name of the edge (u, v).
+func (a *AttributesOf[N]) DeleteEdgeAttr(u, v N, name string) {
+	delete(a.edge[u][v], name)
+}
+
+// empty reports whether no attribute has ever been stored.
+func (a *AttributesOf[N]) empty() bool {
+	return a == nil || (len(a.vertex) == 0 && len(a.edge) == 0)
+}
+
+// removeVertex drops the attributes of v and of all edges at v.
+func (a *AttributesOf[N]) removeVertex(v N) {
+	delete(a.vertex, v)
+	if a.directed {
+		for u := range a.edge {
+			delete(a.edge[u], v)
+		}
+	} else {
+		for u := range a.edge[v] {
+			delete(a.edge[u], v)
+		}
+	}
+	delete(a.edge, v)
+}
+
+// removeEdge drops the attributes of the edge (u, v).
+func (a *AttributesOf[N]) removeEdge(u, v N) {
+	delete(a.edge[u], v)
+	if !a.directed {
+		delete(a.edge[v], u)
+	}
+}
+
+// Clone returns a copy whose maps are independent of a.
+func (a *AttributesOf[N]) Clone() *AttributesOf[N] {
+	if a == nil {
+		return nil
+	}
+	c := newAttributes[N](a.directed)
+	for v, props := range a.vertex {
+		c.vertex[v] = maps.Clone(props)
+	}
+	for u, neighbors := range a.edge {
+		for v, props := range neighbors {
+			if c.edge[u][v] == nil {
+				maps.Copy(c.edgeProps(u, v, true), props)
+			}
+		}
+	}
+	return c
+}
+
+// copyVertexAttrs copies the attributes of vertex v of a to vertex v of b,
+// keeping the values b already has.
+func (a *AttributesOf[N]) copyVertexAttrs(b *AttributesOf[N], v N) {
+	for name, value := range a.vertex[v] {
+		props := b.vertexProps(v, true)
+		if _, exists := props[name]; !exists {
+			props[name] = value
+		}
+	}
+}
+
+// copyEdgeAttrs copies the attributes of the edge (u, v) of a to the edge
+// (x, y) of b, keeping the values b already has.
+func (a *AttributesOf[N]) copyEdgeAttrs(b *AttributesOf[N], u, v, x, y N) {
+	b.addEdgeProps(x, y, a.edge[u][v])
+}
+
+// addEdgeProps sets the values of props on the edge (u, v), keeping the
+// values it already has.
+func (a *AttributesOf[N]) addEdgeProps(u, v N, props map[string]any) {
+	for name, value := range props {
+		p := a.edgeProps(u, v, true)
+		if _, exists := p[name]; !exists {
+			p[name] = value
+		}
+	}
+}
+
+// attributedEdges lists the edges that have attributes, each undirected
+// edge once with u <= v, sorted by endpoints.
+func (a *AttributesOf[N]) attributedEdges() [][2]N {
+	res := make([][2]N, 0)
+	for u, neighbors := range a.edge {
+		for v, props := range neighbors {
+			if len(props) > 0 && (a.directed || u <= v) {
+				res = append(res, [2]N{u, v})
+			}
+		}
+	}
+	slices.SortFunc(res, func(x, y [2]N) int {
+		return cmp.Or(cmp.Compare(x[0], y[0]), cmp.Compare(x[1], y[1]))
+	})
+	return res
+}
+
+// WeightedBy returns a read-only Weighted view of g whose edge weights are
+// the values of the edge attribute k. Edges without the attribute keep
+// their own weight, or weigh 1 if g is unweighted.
+//
+// The view lets algorithms such as mst.PrimMST run on any named weight.
+func WeightedBy(g AttributedGraph, k Key[int]) Weighted {
+	return &attrWeighted{g, k}
+}
+
+type attrWeighted struct {
+	AttributedGraph
+	key Key[int]
+}
+
+func (g *attrWeighted) weight(u, v string) int {
+	if w, ok := EdgeAttr(readAttrs(g.AttributedGraph), u, v, g.key); ok {
+		return w
+	}
+	if wg, ok := g.AttributedGraph.(Weighted); ok {
+		w, _ := wg.GetEdgeWeight(u, v)
+		return w
+	}
+	return 1
+}
+
+func (g *attrWeighted) WeightedNeighbors(vertex string) iter.Seq2[string, int] {
+	return func(yield func(string, int) bool) {
+		for v := range g.Neighbors(vertex) {
+			if !yield(v, g.weight(vertex, v)) {
+				return
+			}
+		}
+	}
+}
+
+func (g *attrWeighted) WeightedEdges() iter.Seq[WeightedEdge] {
+	return func(yield func(WeightedEdge) bool) {
+		for u, v := range g.Edges() {
+			if !yield(WeightedEdge{u, v, g.weight(u, v)}) {
+				return
+			}
+		}
+	}
+}
+
+func (g *attrWeighted) GetEdgeWeight(vertex1, vertex2 string) (int, bool) {
+	if !g.HasEdge(vertex1, vertex2) {
+		return 0, false
+	}
+	return g.weight(vertex1, vertex2), true
+}
@@ -0,0 +1,157 @@
+package graphs
+
+import (
+	"testing"
+)
+
+var (
+	labelKey    = NewKey[string]("label")
+	capacityKey = NewKey[int]("capacity")
+	pointKey    = NewKey[[2]float64]("point")
+)
+
+func TestVertexAttributes(t *testing.T) {
+	graph := NewBasicGraph()
+	graph.AddEdge("A", "B")
+	attrs := graph.Attrs()
+
+	SetVertexAttr(attrs, "A", pointKey, [2]float64{1, 2})
+	SetVertexAttr(attrs, "A", labelKey, "start")
+
+	if p, ok := VertexAttr(attrs, "A", pointKey); !ok || p != [2]float64{1, 2} {
+		t.Errorf("Expected point (1, 2), got %v, %v", p, ok)
+	}
+	if _, ok := VertexAttr(attrs, "B", labelKey); ok {
+		t.Errorf("Expected no label on B")
+	}
+	if _, ok := VertexAttr(attrs, "A", NewKey[int]("label")); ok {
+		t.Errorf("Expected a key of another type not to match")
+	}
+
+	attrs.DeleteVertexAttr("A", labelKey.Name())
+	if _, ok := VertexAttr(attrs, "A", labelKey); ok {
+		t.Errorf("Expected label of A to be deleted")
+	}
+
+	graph.RemoveVertex("A")
+	graph.AddVertex("A")
+	if _, ok := VertexAttr(attrs, "A", pointKey); ok {
+		t.Errorf("Expected attributes to be removed with the vertex")
+	}
+}
+
+func TestEdgeAttributes(t *testing.T) {
+	graph := NewBasicGraph()
+	graph.AddEdge("A", "B")
+	graph.AddEdge("A", "B")
+	graph.AddEdge("B", "C")
+	attrs := graph.Attrs()
+
+	SetEdgeAttr(attrs, "B", "A", capacityKey, 5)
+	SetEdgeAttr(attrs, "B", "C", capacityKey, 7)
+	if c, ok := EdgeAttr(attrs, "A", "B", capacityKey); !ok || c != 5 {
+		t.Errorf("Expected capacity 5 on A-B, got %v, %v", c, ok)
+	}
+
+	graph.RemoveEdge("A", "B")
+	if _, ok := EdgeAttr(attrs, "A", "B", capacityKey); !ok {
+		t.Errorf("Expected attribute to stay while a parallel edge remains")
+	}
+	graph.RemoveEdge("A", "B")
+	if _, ok := EdgeAttr(attrs, "A", "B", capacityKey); ok {
+		t.Errorf("Expected attribute to be removed with the last edge")
+	}
+
+	graph.RemoveVertex("C")
+	if _, ok := EdgeAttr(attrs, "B", "C", capacityKey); ok {
+		t.Errorf("Expected attribute to be removed with the vertex")
+	}
+}
+
+func TestEdgeAttributesInDirectedGraph(t *testing.T) {
+	graph := NewWeightedOrientedGraph()
+	graph.AddEdge("A", "B", 1)
+	graph.AddEdge("B", "A", 1)
+	attrs := graph.Attrs()
+
+	SetEdgeAttr(attrs, "A", "B", labelKey, "forward")
+	if _, ok := EdgeAttr(attrs, "B", "A", labelKey); ok {
+		t.Errorf("Expected opposite arcs to have separate attributes")
+	}
+
+	graph.RemoveVertex("B")
+	if _, ok := EdgeAttr(attrs, "A", "B", labelKey); ok {
+		t.Errorf("Expected attribute to be removed with the vertex")
+	}
+}
+
+func TestAttributesSurviveClone(t *testing.T) {
+	graph := NewWeightedGraph()
+	graph.AddEdge("A", "B", 1)
+	SetVertexAttr(graph.Attrs(), "A", labelKey, "a")
+	SetEdgeAttr(graph.Attrs(), "A", "B", capacityKey, 3)
+
+	clone := graph.Clone()
+	SetVertexAttr(clone.Attrs(), "A", labelKey, "changed")
+	SetEdgeAttr(clone.Attrs(), "B", "A", capacityKey, 4)
+
+	if l, _ := VertexAttr(graph.Attrs(), "A", labelKey); l != "a" {
+		t.Errorf("Expected label a on the original, got %q", l)
+	}
+	if c, _ := EdgeAttr(graph.Attrs(), "A", "B", capacityKey); c != 3 {
+		t.Errorf("Expected capacity 3 on the original, got %d", c)
+	}
+	if c, _ := EdgeAttr(clone.Attrs(), "A", "B", capacityKey); c != 4 {
+		t.Errorf("Expected capacity 4 on the clone, got %d", c)
+	}
+}
+
+func TestAttributesSurviveConversion(t *testing.T) {
+	directed := NewDirectedGraph()
+	directed.AddEdge("A", "B")
+	directed.AddEdge("B", "A")
+	SetVertexAttr(directed.Attrs(), "A", labelKey, "a")
+	SetEdgeAttr(directed.Attrs(), "A", "B", capacityKey, 1)
+	SetEdgeAttr(directed.Attrs(), "B", "A", capacityKey, 2)
+
+	weighted := ToWeightedGraph(directed, ConvertOptions{})
+	if l, _ := VertexAttr(weighted.Attrs(), "A", labelKey); l != "a" {
+		t.Errorf("Expected label a, got %q", l)
+	}
+	if c, _ := EdgeAttr(weighted.Attrs(), "B", "A", capacityKey); c != 1 {
+		t.Errorf("Expected capacity of the first arc, got %d", c)
+	}
+
+	back := ToDirectedGraph(weighted, ConvertOptions{})
+	for _, arc := range [][2]string{{"A", "B"}, {"B", "A"}} {
+		if c, _ := EdgeAttr(back.Attrs(), arc[0], arc[1], capacityKey); c != 1 {
+			t.Errorf("Expected capacity 1 on %v, got %d", arc, c)
+		}
+	}
+}
+
+func TestWeightedBy(t *testing.T) {
+	graph := NewBasicGraph()
+	graph.AddEdge("A", "B")
+	graph.AddEdge("B", "C")
+	SetEdgeAttr(graph.Attrs(), "A", "B", capacityKey, 10)
+
+	weighted := WeightedBy(graph, capacityKey)
+	if w, ok := weighted.GetEdgeWeight("B", "A"); !ok || w != 10 {
+		t.Errorf("Expected weight 10 on A-B, got %v, %v", w, ok)
+	}
+	if w, _ := weighted.GetEdgeWeight("B", "C"); w != 1 {
+		t.Errorf("Expected default weight 1 on B-C, got %d", w)
+	}
+	if _, ok := weighted.GetEdgeWeight("A", "C"); ok {
+		t.Errorf("Expected no edge between A and C")
+	}
+
+	total := 0
+	for e := range weighted.WeightedEdges() {
+		total += e.Weight
+	}
+	if total != 11 {
+		t.Errorf("Expected total weight 11, got %d", total)
+	}
+}
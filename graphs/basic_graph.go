@@ -1,43 +1,218 @@
 package graphs
 
+import (
+	"iter"
+	"maps"
+	"slices"
+)
+
+// BasicGraph is an undirected graph.
+//
+// Adjacency is kept in insertion-ordered sets, so HasEdge and RemoveEdge
+// take constant time.
 type BasicGraph struct {
-	Vertices map[string][]string
+	adj    map[string]*neighborSet
+	policy EdgePolicy
+	attrs  *Attributes
 }
 
+// NewBasicGraph creates a graph that allows duplicate edges and self-loops.
 func NewBasicGraph() *BasicGraph {
+	return NewBasicGraphWithPolicy(EdgePolicy{})
+}
+
+// NewBasicGraphWithPolicy creates a graph whose AddEdge follows policy.
+func NewBasicGraphWithPolicy(policy EdgePolicy) *BasicGraph {
 	return &BasicGraph{
-		Vertices: make(map[string][]string),
+		adj:    make(map[string]*neighborSet),
+		policy: policy,
+	}
+}
+
+// Policy returns the policy AddEdge follows for duplicate edges and
+// self-loops.
+func (g *BasicGraph) Policy() EdgePolicy {
+	return g.policy
+}
+
+// Attrs returns the vertex and edge attributes of the graph.
+func (g *BasicGraph) Attrs() *Attributes {
+	return lazyAttrs(&g.attrs, false)
+}
+
+func (g *BasicGraph) storedAttrs() *Attributes {
+	return g.attrs
+}
+
+// AdjacencyLists returns the adjacency lists of the graph, repeating a neighbor
+// once per parallel edge. The result is a snapshot: changing it does not
+// change the graph.
+func (g *BasicGraph) AdjacencyLists() map[string][]string {
+	res := make(map[string][]string, len(g.adj))
+	for v, neighbors := range g.adj {
+		res[v] = neighbors.list()
 	}
+	return res
+}
+
+// Vertices returns the same snapshot as AdjacencyLists.
+//
+// Deprecated: Vertices used to be an exported field that could be written
+// through; use AdjacencyLists, or AddEdge and RemoveEdge to change the graph.
+func (g *BasicGraph) Vertices() map[string][]string {
+	return g.AdjacencyLists()
 }
 
-func (g *BasicGraph) AddEdge(vertex1, vertex2 string) {
-	g.Vertices[vertex1] = append(g.Vertices[vertex1], vertex2)
-	g.Vertices[vertex2] = append(g.Vertices[vertex2], vertex1)
+// AddEdge adds an edge unless the policy forbids it and reports what happened.
+func (g *BasicGraph) AddEdge(vertex1, vertex2 string) EdgeStatus {
+	if status := g.policy.check(g, vertex1, vertex2); !status.Added() {
+		return status
+	}
+	g.AddVertex(vertex1)
+	g.AddVertex(vertex2)
+	g.adj[vertex1].add(vertex2)
+	g.adj[vertex2].add(vertex1)
+	return EdgeAdded
 }
 
+// RemoveEdge removes one edge between the vertices.
 func (g *BasicGraph) RemoveEdge(vertex1, vertex2 string) {
-	g.Vertices[vertex1] = removeElement(g.Vertices[vertex1], vertex2)
-	g.Vertices[vertex2] = removeElement(g.Vertices[vertex2], vertex1)
+	if !g.HasEdge(vertex1, vertex2) {
+		return
+	}
+	g.adj[vertex1].remove(vertex2)
+	g.adj[vertex2].remove(vertex1)
+	if g.attrs != nil && !g.HasEdge(vertex1, vertex2) {
+		g.attrs.removeEdge(vertex1, vertex2)
+	}
 }
 
+// GetNeighbors returns a copy of the neighbor list of vertex, repeating a
+// neighbor once per parallel edge.
 func (g *BasicGraph) GetNeighbors(vertex string) []string {
-	return g.Vertices[vertex]
+	neighbors, exists := g.adj[vertex]
+	if !exists {
+		return nil
+	}
+	return neighbors.list()
 }
 
 func (g *BasicGraph) HasEdge(vertex1, vertex2 string) bool {
-	for _, v := range g.Vertices[vertex1] {
-		if v == vertex2 {
-			return true
-		}
+	neighbors, exists := g.adj[vertex1]
+	return exists && neighbors.has(vertex2)
+}
+
+// Multiplicity returns the number of parallel edges between the vertices.
+func (g *BasicGraph) Multiplicity(vertex1, vertex2 string) int {
+	neighbors, exists := g.adj[vertex1]
+	if !exists {
+		return 0
 	}
+	// Both endpoints of a self-loop are stored in the same set.
+	if vertex1 == vertex2 {
+		return neighbors.count(vertex2) / 2
+	}
+	return neighbors.count(vertex2)
+}
+
+func (g *BasicGraph) Directed() bool {
 	return false
 }
 
-func removeElement(slice []string, element string) []string {
-	for i, v := range slice {
-		if v == element {
-			return append(slice[:i], slice[i+1:]...)
+func (g *BasicGraph) AllVertices() iter.Seq[string] {
+	return maps.Keys(g.adj)
+}
+
+// Neighbors yields every adjacent vertex once, in the order the edges were added.
+func (g *BasicGraph) Neighbors(vertex string) iter.Seq[string] {
+	neighbors, exists := g.adj[vertex]
+	if !exists {
+		return func(func(string) bool) {}
+	}
+	return neighbors.all()
+}
+
+// Degree counts parallel edges with their multiplicity.
+func (g *BasicGraph) Degree(vertex string) int {
+	neighbors, exists := g.adj[vertex]
+	if !exists {
+		return 0
+	}
+	return neighbors.total()
+}
+
+// AddVertex adds an isolated vertex; an existing vertex is left unchanged.
+func (g *BasicGraph) AddVertex(vertex string) {
+	if _, exists := g.adj[vertex]; !exists {
+		g.adj[vertex] = newNeighborSet()
+	}
+}
+
+// RemoveVertex removes vertex together with all incident edges.
+func (g *BasicGraph) RemoveVertex(vertex string) {
+	neighbors, exists := g.adj[vertex]
+	if !exists {
+		return
+	}
+	for neighbor := range neighbors.all() {
+		if neighbor != vertex {
+			g.adj[neighbor].removeAll(vertex)
+		}
+	}
+	delete(g.adj, vertex)
+	if g.attrs != nil {
+		g.attrs.removeVertex(vertex)
+	}
+}
+
+func (g *BasicGraph) HasVertex(vertex string) bool {
+	_, exists := g.adj[vertex]
+	return exists
+}
+
+func (g *BasicGraph) VertexCount() int {
+	return len(g.adj)
+}
+
+// EdgeCount returns the number of edges; a self-loop is counted once.
+func (g *BasicGraph) EdgeCount() int {
+	endpoints := 0
+	for _, neighbors := range g.adj {
+		endpoints += neighbors.total()
+	}
+	return endpoints / 2
+}
+
+// SortedVertices returns all vertices in ascending order.
+func (g *BasicGraph) SortedVertices() []string {
+	return slices.Sorted(maps.Keys(g.adj))
+}
+
+// Edges yields every edge once as a (u, v) pair with u <= v.
+// Parallel edges are yielded once each.
+func (g *BasicGraph) Edges() iter.Seq2[string, string] {
+	return func(yield func(string, string) bool) {
+		for u, neighbors := range g.adj {
+			for v := range neighbors.all() {
+				if u <= v && !yieldParallel(yield, u, v, neighbors.count(v)) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// IncidentEdges yields (vertex, u) once for every edge between vertex and u.
+func (g *BasicGraph) IncidentEdges(vertex string) iter.Seq2[string, string] {
+	return func(yield func(string, string) bool) {
+		neighbors, exists := g.adj[vertex]
+		if !exists {
+			return
+		}
+		for v := range neighbors.all() {
+			if !yieldParallel(yield, vertex, v, neighbors.count(v)) {
+				return
+			}
 		}
 	}
-	return slice
 }
@@ -1,6 +1,8 @@
 package graphs
 
 import (
+	"reflect"
+	"slices"
 	"testing"
 )
 
@@ -71,3 +73,78 @@ func contains(slice []string, item string) bool {
 	}
 	return false
 }
+
+func TestVertexOperationsInBasicGraph(t *testing.T) {
+	graph := NewBasicGraph()
+	graph.AddVertex("D")
+	graph.AddEdge("A", "B")
+	graph.AddEdge("B", "C")
+	graph.AddEdge("A", "C")
+
+	if !graph.HasVertex("D") || graph.Degree("D") != 0 {
+		t.Errorf("Expected isolated vertex D")
+	}
+	if graph.VertexCount() != 4 || graph.EdgeCount() != 3 {
+		t.Errorf("Expected 4 vertices and 3 edges, got %d and %d", graph.VertexCount(), graph.EdgeCount())
+	}
+
+	graph.RemoveVertex("A")
+	if graph.HasVertex("A") {
+		t.Errorf("Expected vertex A to be removed")
+	}
+	if graph.HasEdge("B", "A") || graph.HasEdge("C", "A") {
+		t.Errorf("Expected edges incident to A to be removed")
+	}
+	if graph.EdgeCount() != 1 {
+		t.Errorf("Expected 1 edge, got %d", graph.EdgeCount())
+	}
+	if !slices.Equal(graph.SortedVertices(), []string{"B", "C", "D"}) {
+		t.Errorf("Expected vertices [B C D], got %v", graph.SortedVertices())
+	}
+}
+
+func TestGetNeighborsReturnsCopyInBasicGraph(t *testing.T) {
+	graph := NewBasicGraph()
+	graph.AddEdge("A", "B")
+	graph.AddEdge("A", "C")
+
+	neighbors := graph.GetNeighbors("A")
+	neighbors[0] = "Z"
+	graph.RemoveEdge("A", "C")
+
+	if !slices.Equal(neighbors, []string{"Z", "C"}) {
+		t.Errorf("Expected returned slice to be unaffected by RemoveEdge, got %v", neighbors)
+	}
+	if !slices.Equal(graph.GetNeighbors("A"), []string{"B"}) {
+		t.Errorf("Expected neighbors [B], got %v", graph.GetNeighbors("A"))
+	}
+
+	snapshot := graph.AdjacencyLists()
+	snapshot["A"] = append(snapshot["A"], "D")
+	if graph.HasEdge("A", "D") {
+		t.Errorf("Expected AdjacencyLists() to return a snapshot")
+	}
+	if !reflect.DeepEqual(graph.Vertices(), graph.AdjacencyLists()) {
+		t.Errorf("Expected Vertices() to match AdjacencyLists()")
+	}
+}
+
+func TestSelfLoopInBasicGraph(t *testing.T) {
+	graph := NewBasicGraph()
+	graph.AddEdge("A", "B")
+	graph.AddEdge("A", "A")
+
+	if m := graph.Multiplicity("A", "A"); m != 1 {
+		t.Errorf("Expected multiplicity 1 for a self-loop, got %d", m)
+	}
+	if d := graph.Degree("A"); d != 3 {
+		t.Errorf("Expected degree 3 for A, got %d", d)
+	}
+	if n := graph.EdgeCount(); n != 2 {
+		t.Errorf("Expected 2 edges, got %d", n)
+	}
+	graph.RemoveEdge("A", "A")
+	if graph.HasEdge("A", "A") {
+		t.Errorf("Expected the self-loop to be removed")
+	}
+}
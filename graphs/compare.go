@@ -0,0 +1,219 @@
+package graphs
+
+import (
+	"cmp"
+	"maps"
+	"slices"
+)
+
+// GraphDiff describes how to turn one graph into another.
+//
+// Edges of undirected graphs are reported with U <= V. For the unweighted
+// graph types the weight of an edge is its multiplicity, so adding or
+// removing a parallel edge shows up in Reweighted. Attributes are not
+// compared.
+type GraphDiff[N Vertex, W Weight] struct {
+	AddedVertices   []N
+	RemovedVertices []N
+	Added           []WeightedEdgeOf[N, W]
+	Removed         []WeightedEdgeOf[N, W]
+	Reweighted      []EdgeChange[N, W]
+}
+
+// EdgeChange is an edge present in both graphs with different weights.
+type EdgeChange[N Vertex, W Weight] struct {
+	U   N
+	V   N
+	Old W
+	New W
+}
+
+// Empty reports whether the two compared graphs are equal.
+func (d GraphDiff[N, W]) Empty() bool {
+	return len(d.AddedVertices) == 0 && len(d.RemovedVertices) == 0 &&
+		len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Reweighted) == 0
+}
+
+type edgeKey[N Vertex] struct {
+	u, v N
+}
+
+// diff compares two graphs given as vertex sets and edge weight maps.
+// All lists of the result are sorted by vertices.
+func diff[N Vertex, W Weight](fromVertices, toVertices []N, from, to map[edgeKey[N]]W) GraphDiff[N, W] {
+	var d GraphDiff[N, W]
+
+	inFrom := make(map[N]struct{}, len(fromVertices))
+	for _, v := range fromVertices {
+		inFrom[v] = struct{}{}
+	}
+	inTo := make(map[N]struct{}, len(toVertices))
+	for _, v := range toVertices {
+		inTo[v] = struct{}{}
+		if _, exists := inFrom[v]; !exists {
+			d.AddedVertices = append(d.AddedVertices, v)
+		}
+	}
+	for _, v := range fromVertices {
+		if _, exists := inTo[v]; !exists {
+			d.RemovedVertices = append(d.RemovedVertices, v)
+		}
+	}
+
+	for k, w := range to {
+		old, exists := from[k]
+		switch {
+		case !exists:
+			d.Added = append(d.Added, WeightedEdgeOf[N, W]{k.u, k.v, w})
+		case old != w:
+			d.Reweighted = append(d.Reweighted, EdgeChange[N, W]{k.u, k.v, old, w})
+		}
+	}
+	for k, w := range from {
+		if _, exists := to[k]; !exists {
+			d.Removed = append(d.Removed, WeightedEdgeOf[N, W]{k.u, k.v, w})
+		}
+	}
+
+	slices.Sort(d.AddedVertices)
+	slices.Sort(d.RemovedVertices)
+	byEndpoints := func(a, b WeightedEdgeOf[N, W]) int {
+		return cmp.Or(cmp.Compare(a.U, b.U), cmp.Compare(a.V, b.V))
+	}
+	slices.SortFunc(d.Added, byEndpoints)
+	slices.SortFunc(d.Removed, byEndpoints)
+	slices.SortFunc(d.Reweighted, func(a, b EdgeChange[N, W]) int {
+		return cmp.Or(cmp.Compare(a.U, b.U), cmp.Compare(a.V, b.V))
+	})
+	return d
+}
+
+// multiplicities maps every edge of g to the number of parallel copies.
+func multiplicities(g Graph) map[edgeKey[string]]int {
+	res := make(map[edgeKey[string]]int)
+	for u, v := range g.Edges() {
+		res[edgeKey[string]{u, v}]++
+	}
+	return res
+}
+
+func edgeWeights[N Vertex, W Weight](edges []WeightedEdgeOf[N, W]) map[edgeKey[N]]W {
+	res := make(map[edgeKey[N]]W, len(edges))
+	for _, e := range edges {
+		res[edgeKey[N]{e.U, e.V}] = e.Weight
+	}
+	return res
+}
+
+func diffUnweighted(from, to Graph) GraphDiff[string, int] {
+	return diff(slices.Collect(from.AllVertices()), slices.Collect(to.AllVertices()), multiplicities(from), multiplicities(to))
+}
+
+// Clone returns a deep copy of the graph, including its edge policy and
+// attributes.
+func (g *BasicGraph) Clone() *BasicGraph {
+	c := NewBasicGraphWithPolicy(g.policy)
+	for v, neighbors := range g.adj {
+		c.adj[v] = neighbors.clone()
+	}
+	c.attrs = g.attrs.Clone()
+	return c
+}
+
+// Equal reports whether both graphs have the same vertices and the same
+// edges with the same multiplicities. Edge policies are not compared.
+func (g *BasicGraph) Equal(other *BasicGraph) bool {
+	return g.Diff(other).Empty()
+}
+
+// Diff returns the changes that turn g into other.
+func (g *BasicGraph) Diff(other *BasicGraph) GraphDiff[string, int] {
+	return diffUnweighted(g, other)
+}
+
+// Clone returns a deep copy of the graph, including its edge policy and
+// attributes.
+func (g *DirectedGraph) Clone() *DirectedGraph {
+	c := NewDirectedGraphWithPolicy(g.policy)
+	for v, neighbors := range g.adj {
+		c.adj[v] = neighbors.clone()
+	}
+	c.attrs = g.attrs.Clone()
+	return c
+}
+
+// Equal reports whether both graphs have the same vertices and the same
+// arcs with the same multiplicities. Edge policies are not compared.
+func (g *DirectedGraph) Equal(other *DirectedGraph) bool {
+	return g.Diff(other).Empty()
+}
+
+// Diff returns the changes that turn g into other.
+func (g *DirectedGraph) Diff(other *DirectedGraph) GraphDiff[string, int] {
+	return diffUnweighted(g, other)
+}
+
+// Clone returns a deep copy of the graph, including its attributes.
+func (g *MultiGraph) Clone() *MultiGraph {
+	c := NewMultiGraph()
+	for v, neighbors := range g.Vertices {
+		c.Vertices[v] = maps.Clone(neighbors)
+	}
+	c.attrs = g.attrs.Clone()
+	return c
+}
+
+// Equal reports whether both graphs have the same vertices and the same
+// edges with the same multiplicities.
+func (g *MultiGraph) Equal(other *MultiGraph) bool {
+	return g.Diff(other).Empty()
+}
+
+// Diff returns the changes that turn g into other.
+func (g *MultiGraph) Diff(other *MultiGraph) GraphDiff[string, int] {
+	return diffUnweighted(g, other)
+}
+
+// Clone returns a deep copy of the graph, including its attributes.
+func (g *WeightedGraphOf[N, W]) Clone() *WeightedGraphOf[N, W] {
+	c := NewWeightedGraphOf[N, W]()
+	for v, neighbors := range g.Vertices {
+		c.Vertices[v] = maps.Clone(neighbors)
+	}
+	c.attrs = g.attrs.Clone()
+	return c
+}
+
+// Equal reports whether both graphs have the same vertices and the same
+// edges with the same weights.
+func (g *WeightedGraphOf[N, W]) Equal(other *WeightedGraphOf[N, W]) bool {
+	return g.Diff(other).Empty()
+}
+
+// Diff returns the changes that turn g into other.
+func (g *WeightedGraphOf[N, W]) Diff(other *WeightedGraphOf[N, W]) GraphDiff[N, W] {
+	return diff(slices.Collect(g.AllVertices()), slices.Collect(other.AllVertices()),
+		edgeWeights(g.GetEdges()), edgeWeights(other.GetEdges()))
+}
+
+// Clone returns a deep copy of the graph, including its attributes.
+func (g *WeightedOrientedGraphOf[N, W]) Clone() *WeightedOrientedGraphOf[N, W] {
+	c := NewWeightedOrientedGraphOf[N, W]()
+	for v, neighbors := range g.vertices {
+		c.vertices[v] = maps.Clone(neighbors)
+	}
+	c.attrs = g.attrs.Clone()
+	return c
+}
+
+// Equal reports whether both graphs have the same vertices and the same
+// arcs with the same weights.
+func (g *WeightedOrientedGraphOf[N, W]) Equal(other *WeightedOrientedGraphOf[N, W]) bool {
+	return g.Diff(other).Empty()
+}
+
+// Diff returns the changes that turn g into other.
+func (g *WeightedOrientedGraphOf[N, W]) Diff(other *WeightedOrientedGraphOf[N, W]) GraphDiff[N, W] {
+	return diff(slices.Collect(g.AllVertices()), slices.Collect(other.AllVertices()),
+		edgeWeights(slices.Collect(g.WeightedEdges())), edgeWeights(slices.Collect(other.WeightedEdges())))
+}
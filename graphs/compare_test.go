@@ -0,0 +1,163 @@
+package graphs
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestCloneInBasicGraph(t *testing.T) {
+	graph := NewBasicGraphWithPolicy(StrictPolicy)
+	graph.AddEdge("A", "B")
+	graph.AddEdge("B", "C")
+	graph.RemoveEdge("A", "B")
+	graph.AddEdge("A", "C")
+
+	clone := graph.Clone()
+	if !clone.Equal(graph) {
+		t.Fatalf("Expected clone to equal the graph, diff %+v", graph.Diff(clone))
+	}
+	if clone.Policy() != StrictPolicy {
+		t.Errorf("Expected clone to keep the policy")
+	}
+	if !slices.Equal(clone.GetNeighbors("C"), graph.GetNeighbors("C")) {
+		t.Errorf("Expected neighbor order %v, got %v", graph.GetNeighbors("C"), clone.GetNeighbors("C"))
+	}
+
+	clone.AddEdge("C", "D")
+	if graph.HasVertex("D") || graph.HasEdge("C", "D") {
+		t.Errorf("Expected changes of the clone not to affect the graph")
+	}
+	if clone.Equal(graph) {
+		t.Errorf("Expected graphs to differ")
+	}
+}
+
+func TestDiffInBasicGraph(t *testing.T) {
+	g1 := NewBasicGraph()
+	g1.AddEdge("A", "B")
+	g1.AddEdge("B", "C")
+	g1.AddEdge("C", "C")
+
+	g2 := g1.Clone()
+	g2.RemoveEdge("B", "C")
+	g2.AddEdge("B", "A")
+	g2.AddEdge("D", "E")
+	g2.RemoveVertex("C")
+
+	d := g1.Diff(g2)
+	if !slices.Equal(d.AddedVertices, []string{"D", "E"}) {
+		t.Errorf("Expected added vertices [D E], got %v", d.AddedVertices)
+	}
+	if !slices.Equal(d.RemovedVertices, []string{"C"}) {
+		t.Errorf("Expected removed vertices [C], got %v", d.RemovedVertices)
+	}
+	if !slices.Equal(d.Added, []WeightedEdge{{"D", "E", 1}}) {
+		t.Errorf("Expected added edges [D-E], got %v", d.Added)
+	}
+	if !slices.Equal(d.Removed, []WeightedEdge{{"B", "C", 1}, {"C", "C", 1}}) {
+		t.Errorf("Expected removed edges [B-C C-C], got %v", d.Removed)
+	}
+	if !slices.Equal(d.Reweighted, []EdgeChange[string, int]{{"A", "B", 1, 2}}) {
+		t.Errorf("Expected multiplicity of A-B to change from 1 to 2, got %v", d.Reweighted)
+	}
+	if d.Empty() {
+		t.Errorf("Expected a non-empty diff")
+	}
+}
+
+func TestEqualInDirectedGraph(t *testing.T) {
+	g1 := NewDirectedGraph()
+	g1.AddEdge("A", "B")
+	g2 := NewDirectedGraph()
+	g2.AddEdge("B", "A")
+
+	if g1.Equal(g2) {
+		t.Errorf("Expected opposite arcs to differ")
+	}
+	d := g1.Diff(g2)
+	if !slices.Equal(d.Added, []WeightedEdge{{"B", "A", 1}}) || !slices.Equal(d.Removed, []WeightedEdge{{"A", "B", 1}}) {
+		t.Errorf("Unexpected diff %+v", d)
+	}
+
+	clone := g1.Clone()
+	clone.AddEdge("B", "A")
+	if g1.HasEdge("B", "A") {
+		t.Errorf("Expected changes of the clone not to affect the graph")
+	}
+}
+
+func TestEqualInMultiGraph(t *testing.T) {
+	g1 := NewMultiGraph()
+	g1.AddEdge("A", "B")
+	g1.AddEdge("A", "B")
+
+	g2 := g1.Clone()
+	if !g1.Equal(g2) {
+		t.Errorf("Expected clone to equal the graph")
+	}
+
+	g2.RemoveEdge("A", "B")
+	if g1.Multiplicity("A", "B") != 2 {
+		t.Errorf("Expected changes of the clone not to affect the graph")
+	}
+	if d := g1.Diff(g2); !slices.Equal(d.Reweighted, []EdgeChange[string, int]{{"A", "B", 2, 1}}) {
+		t.Errorf("Expected multiplicity of A-B to change from 2 to 1, got %+v", d)
+	}
+
+	// An edge removed down to zero copies is the same as no edge at all.
+	g2.RemoveEdge("A", "B")
+	g3 := NewMultiGraph()
+	g3.AddVertex("A")
+	g3.AddVertex("B")
+	if !g2.Equal(g3) {
+		t.Errorf("Expected graphs to be equal, diff %+v", g2.Diff(g3))
+	}
+}
+
+func TestDiffInWeightedGraph(t *testing.T) {
+	g1 := NewWeightedGraph()
+	g1.AddEdge("A", "B", 1)
+	g1.AddEdge("B", "C", 2)
+
+	g2 := g1.Clone()
+	if !g1.Equal(g2) {
+		t.Fatalf("Expected clone to equal the graph")
+	}
+	g2.AddEdge("C", "B", 5)
+	g2.RemoveEdge("A", "B")
+	g2.AddEdge("A", "C", 3)
+
+	d := g1.Diff(g2)
+	if !slices.Equal(d.Added, []WeightedEdge{{"A", "C", 3}}) {
+		t.Errorf("Expected added edges [A-C], got %v", d.Added)
+	}
+	if !slices.Equal(d.Removed, []WeightedEdge{{"A", "B", 1}}) {
+		t.Errorf("Expected removed edges [A-B], got %v", d.Removed)
+	}
+	if !slices.Equal(d.Reweighted, []EdgeChange[string, int]{{"B", "C", 2, 5}}) {
+		t.Errorf("Expected weight of B-C to change from 2 to 5, got %v", d.Reweighted)
+	}
+	if w, _ := g1.GetEdgeWeight("B", "C"); w != 2 {
+		t.Errorf("Expected changes of the clone not to affect the graph")
+	}
+}
+
+func TestDiffInWeightedOrientedGraph(t *testing.T) {
+	g1 := NewWeightedOrientedGraphOf[int, float64]()
+	g1.AddEdge(1, 2, 0.5)
+
+	g2 := g1.Clone()
+	g2.AddEdge(2, 1, 0.5)
+	g2.AddEdge(1, 2, 1.5)
+
+	d := g1.Diff(g2)
+	if !slices.Equal(d.Added, []WeightedEdgeOf[int, float64]{{2, 1, 0.5}}) {
+		t.Errorf("Expected added arcs [2->1], got %v", d.Added)
+	}
+	if !slices.Equal(d.Reweighted, []EdgeChange[int, float64]{{1, 2, 0.5, 1.5}}) {
+		t.Errorf("Expected weight of 1->2 to change from 0.5 to 1.5, got %v", d.Reweighted)
+	}
+	if g1.Equal(g2) || !g2.Equal(g2.Clone()) {
+		t.Errorf("Unexpected result of Equal")
+	}
+}
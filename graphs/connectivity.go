@@ -0,0 +1,205 @@
+package graphs
+
+import (
+	"cmp"
+	"slices"
+)
+
+// The functions below look at the underlying undirected graph: arc
+// directions are ignored and self-loops are dropped, while parallel edges
+// are kept, so an edge with a parallel copy is never a bridge. Results are
+// deterministic: vertex lists are sorted, and lists of lists are sorted by
+// their first elements.
+
+// undirectedAdjacency numbers the vertices of g in sorted order and returns
+// their names and sorted adjacency lists with one entry per parallel edge.
+func undirectedAdjacency(g Graph) ([]string, [][]int) {
+	names := g.SortedVertices()
+	index := make(map[string]int, len(names))
+	for i, v := range names {
+		index[v] = i
+	}
+	adj := make([][]int, len(names))
+	for u, v := range g.Edges() {
+		if u == v {
+			continue
+		}
+		i, j := index[u], index[v]
+		adj[i] = append(adj[i], j)
+		adj[j] = append(adj[j], i)
+	}
+	for _, neighbors := range adj {
+		slices.Sort(neighbors)
+	}
+	return names, adj
+}
+
+// ConnectedComponents returns the vertex sets of the connected components
+// of g; for directed graphs these are the weakly connected components.
+func ConnectedComponents(g Graph) [][]string {
+	names, adj := undirectedAdjacency(g)
+	seen := make([]bool, len(names))
+	res := make([][]string, 0)
+	for start := range names {
+		if seen[start] {
+			continue
+		}
+		seen[start] = true
+		queue := []int{start}
+		for i := 0; i < len(queue); i++ {
+			for _, w := range adj[queue[i]] {
+				if !seen[w] {
+					seen[w] = true
+					queue = append(queue, w)
+				}
+			}
+		}
+		slices.Sort(queue)
+		component := make([]string, len(queue))
+		for i, v := range queue {
+			component[i] = names[v]
+		}
+		res = append(res, component)
+	}
+	return res
+}
+
+// IsConnected reports whether g has at most one connected component.
+func IsConnected(g Graph) bool {
+	return len(ConnectedComponents(g)) <= 1
+}
+
+// Bridges returns the edges whose removal disconnects their endpoints, each
+// as a pair (u, v) with u < v.
+func Bridges(g Graph) [][2]string {
+	return lowpoints(g).bridges
+}
+
+// ArticulationPoints returns the vertices whose removal increases the number
+// of connected components.
+func ArticulationPoints(g Graph) []string {
+	return lowpoints(g).cuts
+}
+
+// BiconnectedComponents returns the vertex sets of the blocks of g: the
+// maximal subgraphs that stay connected after removing any one vertex.
+// A bridge forms a block of two vertices; isolated vertices belong to no
+// block. Blocks share articulation points.
+func BiconnectedComponents(g Graph) [][]string {
+	return lowpoints(g).blocks
+}
+
+type blockTree struct {
+	bridges [][2]string
+	cuts    []string
+	blocks  [][]string
+}
+
+// lowpoints runs the depth-first search of Hopcroft and Tarjan, which finds
+// bridges, articulation points and blocks at once. The search keeps an
+// explicit stack so that long paths do not exhaust the goroutine stack.
+func lowpoints(g Graph) *blockTree {
+	names, adj := undirectedAdjacency(g)
+	n := len(names)
+	disc, low := make([]int, n), make([]int, n)
+	for v := range disc {
+		disc[v] = -1
+	}
+	cut := make([]bool, n)
+	res := &blockTree{bridges: make([][2]string, 0), cuts: make([]string, 0), blocks: make([][]string, 0)}
+
+	type frame struct {
+		v, parent, next, children int
+		// skipped is set once the edge to the parent has been passed over;
+		// further parallel edges to the parent are back edges.
+		skipped bool
+	}
+	// edges holds the edges of the blocks still being explored.
+	edges := make([][2]int, 0)
+	timer := 0
+	for root := range n {
+		if disc[root] >= 0 {
+			continue
+		}
+		disc[root], low[root] = timer, timer
+		timer++
+		stack := []frame{{v: root, parent: -1}}
+		for len(stack) > 0 {
+			f := &stack[len(stack)-1]
+			if f.next < len(adj[f.v]) {
+				w := adj[f.v][f.next]
+				f.next++
+				switch {
+				case w == f.parent && !f.skipped:
+					f.skipped = true
+				case disc[w] < 0:
+					disc[w], low[w] = timer, timer
+					timer++
+					f.children++
+					edges = append(edges, [2]int{f.v, w})
+					stack = append(stack, frame{v: w, parent: f.v})
+				case disc[w] < disc[f.v]:
+					low[f.v] = min(low[f.v], disc[w])
+					edges = append(edges, [2]int{f.v, w})
+				}
+				continue
+			}
+
+			done := *f
+			stack = stack[:len(stack)-1]
+			if len(stack) == 0 {
+				cut[done.v] = done.children > 1
+				break
+			}
+			parent := &stack[len(stack)-1]
+			v, w := parent.v, done.v
+			low[v] = min(low[v], low[w])
+			if low[w] > disc[v] {
+				res.bridges = append(res.bridges, orderedPair(names[v], names[w]))
+			}
+			if low[w] >= disc[v] {
+				if parent.parent >= 0 {
+					cut[v] = true
+				}
+				i := len(edges) - 1
+				for edges[i] != [2]int{v, w} {
+					i--
+				}
+				res.blocks = append(res.blocks, blockVertices(names, edges[i:]))
+				edges = edges[:i]
+			}
+		}
+	}
+
+	for v, isCut := range cut {
+		if isCut {
+			res.cuts = append(res.cuts, names[v])
+		}
+	}
+	slices.SortFunc(res.bridges, func(a, b [2]string) int {
+		return cmp.Or(cmp.Compare(a[0], b[0]), cmp.Compare(a[1], b[1]))
+	})
+	slices.SortFunc(res.blocks, slices.Compare)
+	return res
+}
+
+func orderedPair(u, v string) [2]string {
+	if u > v {
+		u, v = v, u
+	}
+	return [2]string{u, v}
+}
+
+func blockVertices(names []string, edges [][2]int) []string {
+	ids := make([]int, 0, 2*len(edges))
+	for _, e := range edges {
+		ids = append(ids, e[0], e[1])
+	}
+	slices.Sort(ids)
+	ids = slices.Compact(ids)
+	res := make([]string, len(ids))
+	for i, v := range ids {
+		res[i] = names[v]
+	}
+	return res
+}
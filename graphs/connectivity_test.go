@@ -0,0 +1,104 @@
+package graphs
+
+import (
+	"reflect"
+	"testing"
+)
+
+// twoTriangles builds the triangles A-B-C and C-D-E sharing C, a bridge
+// E-F, the isolated vertex G and the component H-I.
+func twoTriangles() *BasicGraph {
+	g := NewBasicGraph()
+	for _, e := range [][2]string{{"A", "B"}, {"B", "C"}, {"C", "A"}, {"C", "D"}, {"D", "E"}, {"E", "C"}, {"E", "F"}, {"H", "I"}} {
+		g.AddEdge(e[0], e[1])
+	}
+	g.AddVertex("G")
+	return g
+}
+
+func TestConnectedComponents(t *testing.T) {
+	got := ConnectedComponents(twoTriangles())
+	expected := [][]string{{"A", "B", "C", "D", "E", "F"}, {"G"}, {"H", "I"}}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+	if IsConnected(twoTriangles()) || !IsConnected(NewBasicGraph()) || !IsConnected(cycleGraph(5)) {
+		t.Errorf("Unexpected IsConnected result")
+	}
+
+	d := NewDirectedGraph()
+	d.AddEdge("A", "B")
+	d.AddEdge("C", "B")
+	if !IsConnected(d) {
+		t.Errorf("Expected a weakly connected directed graph")
+	}
+}
+
+func TestBridgesAndArticulationPoints(t *testing.T) {
+	g := twoTriangles()
+	if got := Bridges(g); !reflect.DeepEqual(got, [][2]string{{"E", "F"}, {"H", "I"}}) {
+		t.Errorf("Unexpected bridges %v", got)
+	}
+	if got := ArticulationPoints(g); !reflect.DeepEqual(got, []string{"C", "E"}) {
+		t.Errorf("Unexpected articulation points %v", got)
+	}
+
+	if got := Bridges(pathGraph(4)); len(got) != 3 {
+		t.Errorf("Every edge of a path is a bridge, got %v", got)
+	}
+	if got := ArticulationPoints(pathGraph(4)); !reflect.DeepEqual(got, []string{"1", "2"}) {
+		t.Errorf("Unexpected articulation points of a path %v", got)
+	}
+	if len(Bridges(cycleGraph(6))) != 0 || len(ArticulationPoints(completeGraph(5))) != 0 {
+		t.Errorf("Expected no bridges in a cycle and no articulation points in K5")
+	}
+}
+
+func TestBridgesInMultigraph(t *testing.T) {
+	g := NewMultiGraph()
+	g.AddEdge("A", "B")
+	g.AddEdge("A", "B")
+	g.AddEdge("B", "C")
+	g.AddEdge("C", "C")
+	if got := Bridges(g); !reflect.DeepEqual(got, [][2]string{{"B", "C"}}) {
+		t.Errorf("Parallel edges are not bridges, got %v", got)
+	}
+	if got := ArticulationPoints(g); !reflect.DeepEqual(got, []string{"B"}) {
+		t.Errorf("Unexpected articulation points %v", got)
+	}
+}
+
+func TestBiconnectedComponents(t *testing.T) {
+	got := BiconnectedComponents(twoTriangles())
+	expected := [][]string{{"A", "B", "C"}, {"C", "D", "E"}, {"E", "F"}, {"H", "I"}}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+
+	w := NewWeightedGraph()
+	w.AddEdge("A", "B", 1)
+	w.AddEdge("B", "C", 2)
+	w.AddEdge("C", "A", 3)
+	w.AddEdge("A", "D", 4)
+	w.AddEdge("D", "E", 5)
+	w.AddEdge("E", "A", 6)
+	got = BiconnectedComponents(w)
+	expected = [][]string{{"A", "B", "C"}, {"A", "D", "E"}}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+}
+
+func TestConnectivityOnLongPath(t *testing.T) {
+	// A recursive search would need a deep stack here.
+	g := pathGraph(50000)
+	if n := len(Bridges(g)); n != 49999 {
+		t.Errorf("Expected 49999 bridges, got %d", n)
+	}
+	if n := len(ArticulationPoints(g)); n != 49998 {
+		t.Errorf("Expected 49998 articulation points, got %d", n)
+	}
+	if got := BiconnectedComponents(g)[0]; !reflect.DeepEqual(got, []string{"0", "1"}) {
+		t.Errorf("Unexpected first block %v", got)
+	}
+}
@@ -0,0 +1,214 @@
+package graphs
+
+import "maps"
+
+// moveEdges lists the edges of g at v2 as they look once v2 is renamed to
+// v1, together with the edges they come from.
+func moveEdges(g Graph, v1, v2 string) (from, to [][2]string) {
+	rename := func(v string) string {
+		if v == v2 {
+			return v1
+		}
+		return v
+	}
+	for _, v := range g.IncidentEdges(v2) {
+		from = append(from, [2]string{v2, v})
+		to = append(to, [2]string{v1, rename(v)})
+	}
+	if g.Directed() {
+		for u := range g.AllVertices() {
+			if u == v2 {
+				continue
+			}
+			for _, v := range g.IncidentEdges(u) {
+				if v == v2 {
+					from = append(from, [2]string{u, v2})
+					to = append(to, [2]string{u, v1})
+				}
+			}
+		}
+	}
+	return from, to
+}
+
+// mergeVertices implements MergeVertices for the unweighted graph types.
+func mergeVertices(g Mutable, attrs *Attributes, v1, v2 string) {
+	if v1 == v2 || !g.HasVertex(v2) {
+		return
+	}
+	from, to := moveEdges(g, v1, v2)
+	// RemoveVertex drops the attributes of the edges at v2, so keep them
+	// until the moved edges are added.
+	props := make([]map[string]any, len(from))
+	for i, e := range from {
+		props[i] = maps.Clone(attrs.edgeProps(e[0], e[1], false))
+	}
+	g.RemoveVertex(v2)
+	g.AddVertex(v1)
+	for i, e := range to {
+		// Edges the policy ignores or rejects must not leave attributes
+		// behind.
+		if g.AddEdge(e[0], e[1]).Added() && len(props[i]) > 0 {
+			attrs.addEdgeProps(e[0], e[1], props[i])
+		}
+	}
+}
+
+// contractEdge implements ContractEdge for the unweighted graph types.
+func contractEdge(g Mutable, attrs *Attributes, v1, v2 string) bool {
+	if v1 == v2 || !g.HasEdge(v1, v2) {
+		return false
+	}
+	for g.HasEdge(v1, v2) {
+		g.RemoveEdge(v1, v2)
+	}
+	for g.HasEdge(v2, v1) {
+		g.RemoveEdge(v2, v1)
+	}
+	mergeVertices(g, attrs, v1, v2)
+	return true
+}
+
+// MergeVertices merges v2 into v1: every edge of v2 is moved to v1 and v2
+// is removed. Edges between v1 and v2 become self-loops at v1. The moved
+// edges go through AddEdge, so with SimplePolicy the graph stays simple.
+// The vertex attributes of v2 are dropped.
+func (g *BasicGraph) MergeVertices(vertex1, vertex2 string) {
+	mergeVertices(g, g.attrs, vertex1, vertex2)
+}
+
+// ContractEdge removes all edges between the vertices and merges vertex2
+// into vertex1. It reports whether there was an edge to contract.
+func (g *BasicGraph) ContractEdge(vertex1, vertex2 string) bool {
+	return contractEdge(g, g.attrs, vertex1, vertex2)
+}
+
+// MergeVertices merges v2 into v1: every arc from or to v2 is moved to v1
+// and v2 is removed. Arcs between v1 and v2 become self-loops at v1. The
+// moved arcs go through AddEdge and follow the edge policy.
+// The vertex attributes of v2 are dropped.
+func (g *DirectedGraph) MergeVertices(vertex1, vertex2 string) {
+	mergeVertices(g, g.attrs, vertex1, vertex2)
+}
+
+// ContractEdge removes all arcs between the vertices, in both directions,
+// and merges vertex2 into vertex1. It reports whether there was an arc
+// from vertex1 to vertex2.
+func (g *DirectedGraph) ContractEdge(vertex1, vertex2 string) bool {
+	return contractEdge(g, g.attrs, vertex1, vertex2)
+}
+
+// MergeVertices merges v2 into v1: the multiplicities of the edges of v2
+// are added to those of v1 and v2 is removed. Edges between v1 and v2
+// become self-loops at v1. The vertex attributes of v2 are dropped.
+func (g *MultiGraph) MergeVertices(vertex1, vertex2 string) {
+	mergeVertices(g, g.attrs, vertex1, vertex2)
+}
+
+// ContractEdge removes all edges between the vertices and merges vertex2
+// into vertex1. It reports whether there was an edge to contract.
+func (g *MultiGraph) ContractEdge(vertex1, vertex2 string) bool {
+	return contractEdge(g, g.attrs, vertex1, vertex2)
+}
+
+// mergeWeighted moves the edges of v2 in adj (and, for directed graphs,
+// the arcs into v2) to v1, merging weights with merge.
+func mergeWeighted[N Vertex, W Weight](adj map[N]map[N]W, attrs *AttributesOf[N], directed bool, v1, v2 N, merge WeightMerge) {
+	type edge struct {
+		from, to [2]N
+		weight   W
+	}
+	rename := func(v N) N {
+		if v == v2 {
+			return v1
+		}
+		return v
+	}
+	moved := make([]edge, 0, len(adj[v2]))
+	for v, w := range adj[v2] {
+		moved = append(moved, edge{[2]N{v2, v}, [2]N{v1, rename(v)}, w})
+	}
+	if directed {
+		for u, neighbors := range adj {
+			if w, exists := neighbors[v2]; exists && u != v2 {
+				moved = append(moved, edge{[2]N{u, v2}, [2]N{u, v1}, w})
+			}
+		}
+	}
+	if attrs != nil {
+		for _, e := range moved {
+			attrs.copyEdgeAttrs(attrs, e.from[0], e.from[1], e.to[0], e.to[1])
+		}
+	}
+
+	for v := range adj[v2] {
+		delete(adj[v], v2)
+	}
+	for _, neighbors := range adj {
+		delete(neighbors, v2)
+	}
+	delete(adj, v2)
+	if attrs != nil {
+		attrs.removeVertex(v2)
+	}
+	if adj[v1] == nil {
+		adj[v1] = make(map[N]W)
+	}
+
+	for _, e := range moved {
+		u, v, w := e.to[0], e.to[1], e.weight
+		if old, exists := adj[u][v]; exists {
+			w = mergeWeights(merge, old, w)
+		}
+		adj[u][v] = w
+		if !directed {
+			adj[v][u] = w
+		}
+	}
+}
+
+// MergeVertices merges v2 into v1: every edge of v2 is moved to v1 and v2
+// is removed. Edges between v1 and v2 become a self-loop at v1; weights of
+// edges that meet are combined with merge. The vertex attributes of v2 are
+// dropped.
+func (g *WeightedGraphOf[N, W]) MergeVertices(vertex1, vertex2 N, merge WeightMerge) {
+	if vertex1 == vertex2 || !g.HasVertex(vertex2) {
+		return
+	}
+	mergeWeighted(g.Vertices, g.attrs, false, vertex1, vertex2, merge)
+}
+
+// ContractEdge removes the edge between the vertices and merges vertex2
+// into vertex1. It reports whether there was an edge to contract.
+func (g *WeightedGraphOf[N, W]) ContractEdge(vertex1, vertex2 N, merge WeightMerge) bool {
+	if vertex1 == vertex2 || !g.HasEdge(vertex1, vertex2) {
+		return false
+	}
+	g.RemoveEdge(vertex1, vertex2)
+	g.MergeVertices(vertex1, vertex2, merge)
+	return true
+}
+
+// MergeVertices merges v2 into v1: every arc from or to v2 is moved to v1
+// and v2 is removed. Arcs between v1 and v2 become a self-loop at v1;
+// weights of arcs that meet are combined with merge. The vertex attributes
+// of v2 are dropped.
+func (g *WeightedOrientedGraphOf[N, W]) MergeVertices(vertex1, vertex2 N, merge WeightMerge) {
+	if vertex1 == vertex2 || !g.HasVertex(vertex2) {
+		return
+	}
+	mergeWeighted(g.vertices, g.attrs, true, vertex1, vertex2, merge)
+}
+
+// ContractEdge removes the arcs between the vertices, in both directions,
+// and merges vertex2 into vertex1. It reports whether there was an arc
+// from vertex1 to vertex2.
+func (g *WeightedOrientedGraphOf[N, W]) ContractEdge(vertex1, vertex2 N, merge WeightMerge) bool {
+	if vertex1 == vertex2 || !g.HasEdge(vertex1, vertex2) {
+		return false
+	}
+	g.RemoveEdge(vertex1, vertex2)
+	g.RemoveEdge(vertex2, vertex1)
+	g.MergeVertices(vertex1, vertex2, merge)
+	return true
+}
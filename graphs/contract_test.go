@@ -0,0 +1,150 @@
+package graphs
+
+import (
+	"testing"
+)
+
+func TestContractEdgeInBasicGraph(t *testing.T) {
+	graph := NewBasicGraph()
+	graph.AddEdge("A", "B")
+	graph.AddEdge("B", "C")
+	graph.AddEdge("A", "C")
+
+	if !graph.ContractEdge("A", "B") {
+		t.Fatalf("Expected edge A-B to be contracted")
+	}
+	expected := NewBasicGraph()
+	expected.AddEdge("A", "C")
+	expected.AddEdge("A", "C")
+	if !graph.Equal(expected) {
+		t.Errorf("Expected a double edge A-C, diff %+v", graph.Diff(expected))
+	}
+	if graph.ContractEdge("A", "B") {
+		t.Errorf("Expected no edge A-B to contract")
+	}
+}
+
+func TestMergeVerticesWithSimplePolicy(t *testing.T) {
+	graph := NewBasicGraphWithPolicy(SimplePolicy)
+	graph.AddEdge("A", "B")
+	graph.AddEdge("B", "C")
+	graph.AddEdge("A", "C")
+
+	graph.MergeVertices("A", "B")
+	if graph.HasVertex("B") || graph.HasEdge("A", "A") {
+		t.Errorf("Expected B to be gone and no self-loop at A")
+	}
+	if m := graph.Multiplicity("A", "C"); m != 1 {
+		t.Errorf("Expected a single edge A-C, got %d", m)
+	}
+}
+
+func TestContractEdgeWithSimplePolicyKeepsNoOrphanAttributes(t *testing.T) {
+	graph := NewBasicGraphWithPolicy(SimplePolicy)
+	graph.AddEdge("A", "B")
+	graph.AddEdge("B", "C")
+	graph.AddEdge("A", "C")
+	graph.AddEdge("C", "D")
+	SetEdgeAttr(graph.Attrs(), "B", "C", labelKey, "bc")
+	SetEdgeAttr(graph.Attrs(), "A", "C", capacityKey, 5)
+	SetEdgeAttr(graph.Attrs(), "C", "D", labelKey, "cd")
+
+	// B-C duplicates A-C and is ignored, so its label must not move there.
+	graph.ContractEdge("A", "B")
+	if _, ok := EdgeAttr(graph.Attrs(), "A", "C", labelKey); ok {
+		t.Errorf("Expected no label on A-C")
+	}
+	if c, _ := EdgeAttr(graph.Attrs(), "A", "C", capacityKey); c != 5 {
+		t.Errorf("Expected capacity 5 on A-C, got %d", c)
+	}
+
+	// C-D becomes a self-loop that the policy ignores.
+	graph.MergeVertices("D", "C")
+	graph.MergeVertices("A", "D")
+	if edges := graph.Attrs().attributedEdges(); len(edges) != 0 {
+		t.Errorf("Expected no edge attributes, got them on %v", edges)
+	}
+}
+
+func TestMergeVerticesInMultiGraph(t *testing.T) {
+	graph := NewMultiGraph()
+	graph.AddEdge("A", "B")
+	graph.AddEdge("A", "C")
+	graph.AddEdge("B", "C")
+	graph.AddEdge("B", "C")
+	SetEdgeAttr(graph.Attrs(), "B", "C", labelKey, "bc")
+
+	graph.MergeVertices("A", "B")
+	if m := graph.Multiplicity("A", "C"); m != 3 {
+		t.Errorf("Expected multiplicity 3 for A-C, got %d", m)
+	}
+	if graph.EdgeCount() != 4 || !graph.HasEdge("A", "A") {
+		t.Errorf("Expected edge A-B to become a self-loop, got %v", graph)
+	}
+	if l, _ := EdgeAttr(graph.Attrs(), "A", "C", labelKey); l != "bc" {
+		t.Errorf("Expected moved edge to keep its label, got %q", l)
+	}
+
+	if !graph.ContractEdge("A", "C") || graph.EdgeCount() != 1 {
+		t.Errorf("Expected only the self-loop to remain, got %v", graph)
+	}
+}
+
+func TestMergeVerticesInDirectedGraph(t *testing.T) {
+	graph := NewDirectedGraph()
+	graph.AddEdge("A", "B")
+	graph.AddEdge("C", "B")
+	graph.AddEdge("B", "D")
+
+	if !graph.ContractEdge("A", "B") {
+		t.Fatalf("Expected arc A->B to be contracted")
+	}
+	expected := NewDirectedGraph()
+	expected.AddEdge("C", "A")
+	expected.AddEdge("A", "D")
+	if !graph.Equal(expected) {
+		t.Errorf("Expected arcs C->A and A->D, diff %+v", graph.Diff(expected))
+	}
+	if graph.ContractEdge("D", "A") {
+		t.Errorf("Expected no arc D->A to contract")
+	}
+}
+
+func TestMergeVerticesInWeightedGraph(t *testing.T) {
+	graph := NewWeightedGraph()
+	graph.AddEdge("A", "B", 1)
+	graph.AddEdge("A", "C", 5)
+	graph.AddEdge("B", "C", 2)
+	graph.AddEdge("B", "D", 4)
+
+	clone := graph.Clone()
+	clone.ContractEdge("A", "B", MergeMin)
+	expected := NewWeightedGraph()
+	expected.AddEdge("A", "C", 2)
+	expected.AddEdge("A", "D", 4)
+	if !clone.Equal(expected) {
+		t.Errorf("Expected lighter A-C to win, diff %+v", clone.Diff(expected))
+	}
+
+	graph.MergeVertices("A", "B", MergeSum)
+	if w, _ := graph.GetEdgeWeight("A", "C"); w != 7 {
+		t.Errorf("Expected summed weight 7 on A-C, got %d", w)
+	}
+	if w, _ := graph.GetEdgeWeight("A", "A"); w != 1 {
+		t.Errorf("Expected a self-loop of weight 1 at A, got %d", w)
+	}
+}
+
+func TestMergeVerticesInWeightedOrientedGraph(t *testing.T) {
+	graph := NewWeightedOrientedGraph()
+	graph.AddEdge("A", "B", 1)
+	graph.AddEdge("C", "B", 2)
+	graph.AddEdge("C", "A", 3)
+
+	graph.ContractEdge("A", "B", MergeMax)
+	expected := NewWeightedOrientedGraph()
+	expected.AddEdge("C", "A", 3)
+	if !graph.Equal(expected) {
+		t.Errorf("Expected a single arc C->A of weight 3, diff %+v", graph.Diff(expected))
+	}
+}
@@ -0,0 +1,258 @@
+package graphs
+
+import (
+	"cmp"
+	"iter"
+	"maps"
+	"slices"
+)
+
+// WeightMerge says how the weights of several edges are combined when a
+// conversion merges them into one edge.
+type WeightMerge int
+
+const (
+	// MergeSum adds the weights up. Edges of unweighted graphs have weight
+	// 1, so a collapsed multigraph edge gets its multiplicity as weight.
+	MergeSum WeightMerge = iota
+	// MergeMin keeps the smallest weight.
+	MergeMin
+	// MergeMax keeps the largest weight.
+	MergeMax
+)
+
+func mergeWeights[W Weight](m WeightMerge, a, b W) W {
+	switch m {
+	case MergeMin:
+		return min(a, b)
+	case MergeMax:
+		return max(a, b)
+	default:
+		return a + b
+	}
+}
+
+// ConvertOptions controls the conversions between graph types.
+//
+// A conversion first turns every edge of the source into edges of the
+// target's kind: a directed arc becomes an undirected edge, an undirected
+// edge becomes a pair of opposite arcs. Edges of unweighted graphs have
+// weight 1. The zero value keeps parallel edges where the target can hold
+// them and sums the weights where it cannot. Vertex and edge attributes are
+// copied to the result.
+type ConvertOptions struct {
+	// Merge combines the weights of edges that end up between the same
+	// vertices of a weighted target.
+	Merge WeightMerge
+	// Collapse keeps at most one edge between two vertices of an
+	// unweighted target.
+	Collapse bool
+	// WeightAsMultiplicity turns an edge of weight w into w parallel edges
+	// of an unweighted target; edges of weight w <= 0 are dropped.
+	WeightAsMultiplicity bool
+	// OneWay turns an undirected edge {u, v} into the single arc u -> v
+	// with u <= v instead of a pair of opposite arcs.
+	OneWay bool
+	// DropSelfLoops leaves self-loops out of the target.
+	DropSelfLoops bool
+}
+
+// sourceEdges yields every edge of g with its weight, parallel edges once each.
+func sourceEdges(g Graph) iter.Seq[WeightedEdge] {
+	if wg, ok := g.(Weighted); ok {
+		return wg.WeightedEdges()
+	}
+	return func(yield func(WeightedEdge) bool) {
+		for u, v := range g.Edges() {
+			if !yield(WeightedEdge{u, v, 1}) {
+				return
+			}
+		}
+	}
+}
+
+// edges lists the edges of g turned into edges of a directed or undirected
+// target, sorted by endpoints so that conversions are deterministic.
+func (o ConvertOptions) edges(g Graph, directed bool) []WeightedEdge {
+	res := make([]WeightedEdge, 0)
+	for e := range sourceEdges(g) {
+		if o.DropSelfLoops && e.U == e.V {
+			continue
+		}
+		switch {
+		case !directed:
+			if e.U > e.V {
+				e.U, e.V = e.V, e.U
+			}
+			res = append(res, e)
+		case g.Directed():
+			res = append(res, e)
+		default:
+			res = append(res, e)
+			if !o.OneWay && e.U != e.V {
+				res = append(res, WeightedEdge{e.V, e.U, e.Weight})
+			}
+		}
+	}
+	slices.SortStableFunc(res, func(a, b WeightedEdge) int {
+		return cmp.Or(cmp.Compare(a.U, b.U), cmp.Compare(a.V, b.V))
+	})
+	return res
+}
+
+// merged merges edges, sorted as by edges, that share their endpoints into
+// one. It reuses the storage of edges.
+func merged(edges []WeightedEdge, merge WeightMerge) []WeightedEdge {
+	res := edges[:0]
+	for _, e := range edges {
+		if last := len(res) - 1; last >= 0 && res[last].U == e.U && res[last].V == e.V {
+			res[last].Weight = mergeWeights(merge, res[last].Weight, e.Weight)
+			continue
+		}
+		res = append(res, e)
+	}
+	return res
+}
+
+// copies returns how many edges of an unweighted target e becomes.
+func (o ConvertOptions) copies(e WeightedEdge) int {
+	if o.WeightAsMultiplicity {
+		return max(e.Weight, 0)
+	}
+	return 1
+}
+
+// unweighted feeds the edges of g into add, applying Collapse and
+// WeightAsMultiplicity.
+func (o ConvertOptions) unweighted(g Graph, directed bool, add func(u, v string)) {
+	edges := o.edges(g, directed)
+	if o.Collapse {
+		edges = merged(edges, o.Merge)
+	}
+	for _, e := range edges {
+		n := o.copies(e)
+		if o.Collapse {
+			n = min(n, 1)
+		}
+		for range n {
+			add(e.U, e.V)
+		}
+	}
+}
+
+func sortedVertices(g Graph) []string {
+	return slices.Sorted(g.AllVertices())
+}
+
+// copyAttrs copies the attributes of from to the corresponding vertices and
+// edges of to. When several edges become one, the attributes of the first
+// edge in endpoint order take precedence.
+func copyAttrs(from Graph, to AttributedGraph) {
+	a := readAttrs(from)
+	if a.empty() {
+		return
+	}
+	b := to.Attrs()
+	for _, v := range slices.Sorted(maps.Keys(a.vertex)) {
+		if to.HasVertex(v) {
+			a.copyVertexAttrs(b, v)
+		}
+	}
+	for _, e := range a.attributedEdges() {
+		u, v := e[0], e[1]
+		if to.HasEdge(u, v) {
+			a.copyEdgeAttrs(b, u, v, u, v)
+		}
+		if to.Directed() && !from.Directed() && to.HasEdge(v, u) {
+			a.copyEdgeAttrs(b, u, v, v, u)
+		}
+	}
+}
+
+// ToBasicGraph converts g into an undirected graph.
+func ToBasicGraph(g Graph, opts ConvertOptions) *BasicGraph {
+	res := NewBasicGraph()
+	for _, v := range sortedVertices(g) {
+		res.AddVertex(v)
+	}
+	opts.unweighted(g, false, func(u, v string) { res.AddEdge(u, v) })
+	copyAttrs(g, res)
+	return res
+}
+
+// ToDirectedGraph converts g into a directed graph.
+func ToDirectedGraph(g Graph, opts ConvertOptions) *DirectedGraph {
+	res := NewDirectedGraph()
+	for _, v := range sortedVertices(g) {
+		res.AddVertex(v)
+	}
+	opts.unweighted(g, true, func(u, v string) { res.AddEdge(u, v) })
+	copyAttrs(g, res)
+	return res
+}
+
+// ToMultiGraph converts g into a multigraph.
+func ToMultiGraph(g Graph, opts ConvertOptions) *MultiGraph {
+	res := NewMultiGraph()
+	for _, v := range sortedVertices(g) {
+		res.AddVertex(v)
+	}
+	opts.unweighted(g, false, func(u, v string) { res.AddEdge(u, v) })
+	copyAttrs(g, res)
+	return res
+}
+
+// ToWeightedGraph converts g into an undirected weighted graph; edges
+// between the same vertices are merged with opts.Merge.
+func ToWeightedGraph(g Graph, opts ConvertOptions) *WeightedGraph {
+	res := NewWeightedGraph()
+	for _, v := range sortedVertices(g) {
+		res.AddVertex(v)
+	}
+	for _, e := range merged(opts.edges(g, false), opts.Merge) {
+		res.AddEdge(e.U, e.V, e.Weight)
+	}
+	copyAttrs(g, res)
+	return res
+}
+
+// ToWeightedOrientedGraph converts g into a directed weighted graph; arcs
+// between the same vertices are merged with opts.Merge.
+func ToWeightedOrientedGraph(g Graph, opts ConvertOptions) *WeightedOrientedGraph {
+	res := NewWeightedOrientedGraph()
+	for _, v := range sortedVertices(g) {
+		res.AddVertex(v)
+	}
+	for _, e := range merged(opts.edges(g, true), opts.Merge) {
+		res.AddEdge(e.U, e.V, e.Weight)
+	}
+	copyAttrs(g, res)
+	return res
+}
+
+// ToAdjacencyMap numbers the vertices of g in sorted order and returns
+// their adjacency lists by number, the input format of the matching
+// algorithms, together with the vertex names. Undirected edges are listed
+// at both ends and parallel edges once per copy.
+func ToAdjacencyMap(g Graph) (map[int][]int, []string) {
+	names := sortedVertices(g)
+	ids := make(map[string]int, len(names))
+	for i, v := range names {
+		ids[v] = i
+	}
+	adj := make(map[int][]int, len(names))
+	for i := range names {
+		adj[i] = []int{}
+	}
+	for _, e := range (ConvertOptions{}).edges(g, g.Directed()) {
+		u, v := ids[e.U], ids[e.V]
+		adj[u] = append(adj[u], v)
+		if !g.Directed() && u != v {
+			adj[v] = append(adj[v], u)
+		}
+	}
+	for _, neighbors := range adj {
+		slices.Sort(neighbors)
+	}
+	return adj, names
+}
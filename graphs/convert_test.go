@@ -0,0 +1,137 @@
+package graphs
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestDirectedToBasicGraph(t *testing.T) {
+	directed := NewDirectedGraph()
+	directed.AddEdge("A", "B")
+	directed.AddEdge("B", "A")
+	directed.AddEdge("B", "C")
+	directed.AddVertex("D")
+
+	basic := ToBasicGraph(directed, ConvertOptions{})
+	if m := basic.Multiplicity("A", "B"); m != 2 {
+		t.Errorf("Expected opposite arcs to become 2 parallel edges, got %d", m)
+	}
+	if !basic.HasEdge("C", "B") || !basic.HasVertex("D") {
+		t.Errorf("Expected edge B-C and isolated vertex D, got %v", basic.AdjacencyLists())
+	}
+
+	collapsed := ToBasicGraph(directed, ConvertOptions{Collapse: true})
+	if m := collapsed.Multiplicity("A", "B"); m != 1 {
+		t.Errorf("Expected a single edge A-B, got %d", m)
+	}
+}
+
+func TestBasicToDirectedGraph(t *testing.T) {
+	basic := NewBasicGraph()
+	basic.AddEdge("B", "A")
+	basic.AddEdge("C", "C")
+
+	directed := ToDirectedGraph(basic, ConvertOptions{})
+	if !directed.HasEdge("A", "B") || !directed.HasEdge("B", "A") {
+		t.Errorf("Expected both arcs between A and B")
+	}
+	if m := directed.Multiplicity("C", "C"); m != 1 {
+		t.Errorf("Expected a single self-loop arc at C, got %d", m)
+	}
+
+	oneWay := ToDirectedGraph(basic, ConvertOptions{OneWay: true, DropSelfLoops: true})
+	if !oneWay.HasEdge("A", "B") || oneWay.HasEdge("B", "A") || oneWay.HasEdge("C", "C") {
+		t.Errorf("Expected only the arc A->B, got %v", oneWay.AdjacencyLists())
+	}
+	if !oneWay.HasVertex("C") {
+		t.Errorf("Expected vertex C to be kept")
+	}
+}
+
+func TestMultiToWeightedGraph(t *testing.T) {
+	multi := NewMultiGraph()
+	multi.AddEdge("A", "B")
+	multi.AddEdge("A", "B")
+	multi.AddEdge("A", "B")
+	multi.AddEdge("B", "C")
+
+	weighted := ToWeightedGraph(multi, ConvertOptions{})
+	expected := NewWeightedGraph()
+	expected.AddEdge("A", "B", 3)
+	expected.AddEdge("B", "C", 1)
+	if !weighted.Equal(expected) {
+		t.Errorf("Expected multiplicities as weights, diff %+v", weighted.Diff(expected))
+	}
+
+	if w, _ := ToWeightedGraph(multi, ConvertOptions{Merge: MergeMin}).GetEdgeWeight("A", "B"); w != 1 {
+		t.Errorf("Expected weight 1 with MergeMin, got %d", w)
+	}
+}
+
+func TestWeightedToMultiGraph(t *testing.T) {
+	weighted := NewWeightedGraph()
+	weighted.AddEdge("A", "B", 3)
+	weighted.AddEdge("B", "C", 0)
+
+	multi := ToMultiGraph(weighted, ConvertOptions{WeightAsMultiplicity: true})
+	if m := multi.Multiplicity("A", "B"); m != 3 {
+		t.Errorf("Expected multiplicity 3, got %d", m)
+	}
+	if multi.HasEdge("B", "C") {
+		t.Errorf("Expected edge of weight 0 to be dropped")
+	}
+
+	simple := ToMultiGraph(weighted, ConvertOptions{})
+	if m := simple.Multiplicity("A", "B"); m != 1 {
+		t.Errorf("Expected multiplicity 1, got %d", m)
+	}
+
+	roundTrip := ToWeightedGraph(multi, ConvertOptions{})
+	if w, _ := roundTrip.GetEdgeWeight("A", "B"); w != 3 {
+		t.Errorf("Expected weight 3 after round trip, got %d", w)
+	}
+}
+
+func TestOrientedToWeightedGraph(t *testing.T) {
+	oriented := NewWeightedOrientedGraph()
+	oriented.AddEdge("A", "B", 2)
+	oriented.AddEdge("B", "A", 5)
+
+	tests := []struct {
+		merge    WeightMerge
+		expected int
+	}{
+		{MergeSum, 7},
+		{MergeMin, 2},
+		{MergeMax, 5},
+	}
+	for _, tt := range tests {
+		weighted := ToWeightedGraph(oriented, ConvertOptions{Merge: tt.merge})
+		if w, _ := weighted.GetEdgeWeight("A", "B"); w != tt.expected {
+			t.Errorf("Expected weight %d with merge %d, got %d", tt.expected, tt.merge, w)
+		}
+	}
+
+	back := ToWeightedOrientedGraph(ToWeightedGraph(oriented, ConvertOptions{Merge: MergeMin}), ConvertOptions{})
+	if w, _ := back.GetEdgeWeight("B", "A"); w != 2 {
+		t.Errorf("Expected weight 2 on B->A, got %d", w)
+	}
+}
+
+func TestToAdjacencyMap(t *testing.T) {
+	basic := NewBasicGraph()
+	basic.AddEdge("x", "y")
+	basic.AddEdge("y", "z")
+	basic.AddVertex("w")
+
+	adj, names := ToAdjacencyMap(basic)
+	if !slices.Equal(names, []string{"w", "x", "y", "z"}) {
+		t.Fatalf("Expected names [w x y z], got %v", names)
+	}
+	expected := map[int][]int{0: {}, 1: {2}, 2: {1, 3}, 3: {2}}
+	for v, neighbors := range expected {
+		if !slices.Equal(adj[v], neighbors) {
+			t.Errorf("Expected neighbors %v of %d, got %v", neighbors, v, adj[v])
+		}
+	}
+}
@@ -0,0 +1,345 @@
+package graphs
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+	"strconv"
+)
+
+// CSRGraphOf is an immutable graph in compressed sparse row form whose
+// arcs carry weights of type W.
+//
+// Vertices are the integers 0..n-1. The arcs leaving vertex u are the
+// positions index[u]..index[u+1]-1 of the targets and weights arrays.
+// An undirected edge is stored as two arcs, one in each direction, and a
+// self-loop as a single arc.
+//
+// Vertex names are either supplied by the builder (ToCSR) or are the
+// decimal representations of the vertex indices (NewCSRGraph), which is
+// how the ECL files name their vertices.
+type CSRGraphOf[W Weight] struct {
+	directed bool
+	index    []int32
+	targets  []int32
+	weights  []W
+	names    []string
+	ids      map[string]int32
+}
+
+// CSRGraph is the int-weight CSR graph used throughout the repository.
+type CSRGraph = CSRGraphOf[int]
+
+// NewCSRGraph wraps the given arrays without copying them.
+//
+// weights may be nil, in which case every arc has weight 1.
+// The arrays must not be modified after the call.
+func NewCSRGraph(index, targets []int32, weights []int, directed bool) (*CSRGraph, error) {
+	return NewCSRGraphOf(index, targets, weights, directed)
+}
+
+// NewCSRGraphOf is NewCSRGraph for weights of any type.
+func NewCSRGraphOf[W Weight](index, targets []int32, weights []W, directed bool) (*CSRGraphOf[W], error) {
+	if len(index) == 0 || index[0] != 0 {
+		return nil, fmt.Errorf("invalid index array structure")
+	}
+	n := int32(len(index) - 1)
+	for u := int32(0); u < n; u++ {
+		if index[u] > index[u+1] {
+			return nil, fmt.Errorf("invalid index range for vertex %d", u)
+		}
+	}
+	if int(index[n]) != len(targets) {
+		return nil, fmt.Errorf("index array ends at %d, but there are %d arcs", index[n], len(targets))
+	}
+	for i, v := range targets {
+		if v < 0 || v >= n {
+			return nil, fmt.Errorf("invalid target %d of arc %d", v, i)
+		}
+	}
+	if weights != nil && len(weights) != len(targets) {
+		return nil, fmt.Errorf("not enough weights (arcs are %d, but weights are %d)", len(targets), len(weights))
+	}
+	return &CSRGraphOf[W]{
+		directed: directed,
+		index:    index,
+		targets:  targets,
+		weights:  weights,
+	}, nil
+}
+
+// ToCSR builds a CSRGraph from any graph.
+//
+// Vertices are numbered in sorted name order and the arcs of every vertex
+// are sorted by target. Weights are taken from Weighted graphs; parallel
+// edges of Multi graphs become parallel arcs. A CSRGraph is returned as is.
+func ToCSR(g Graph) *CSRGraph {
+	return ToCSROf[int](g)
+}
+
+// ToCSROf is ToCSR for weights of type W: they are taken from graphs that
+// implement WeightedOf[W], other graphs get weight 1 on every arc.
+func ToCSROf[W Weight](g Graph) *CSRGraphOf[W] {
+	if c, ok := g.(*CSRGraphOf[W]); ok {
+		return c
+	}
+
+	seen := make(map[string]struct{})
+	for u := range g.AllVertices() {
+		seen[u] = struct{}{}
+		for v := range g.Neighbors(u) {
+			seen[v] = struct{}{}
+		}
+	}
+	names := make([]string, 0, len(seen))
+	for v := range seen {
+		names = append(names, v)
+	}
+	slices.Sort(names)
+	ids := make(map[string]int32, len(names))
+	for i, v := range names {
+		ids[v] = int32(i)
+	}
+
+	wg, weighted := g.(WeightedOf[W])
+	mg, multi := g.(Multi)
+
+	type arc struct {
+		target int32
+		weight W
+	}
+	index := make([]int32, len(names)+1)
+	targets := make([]int32, 0)
+	var weights []W
+	if weighted {
+		weights = make([]W, 0)
+	}
+	arcs := make([]arc, 0)
+	for i, u := range names {
+		arcs = arcs[:0]
+		if weighted {
+			for v, w := range wg.WeightedNeighbors(u) {
+				arcs = append(arcs, arc{ids[v], w})
+			}
+		} else {
+			for v := range g.Neighbors(u) {
+				count := 1
+				if multi {
+					count = mg.Multiplicity(u, v)
+				}
+				for range count {
+					arcs = append(arcs, arc{ids[v], 1})
+				}
+			}
+		}
+		slices.SortStableFunc(arcs, func(a, b arc) int { return int(a.target) - int(b.target) })
+		for _, a := range arcs {
+			targets = append(targets, a.target)
+			if weighted {
+				weights = append(weights, a.weight)
+			}
+		}
+		index[i+1] = int32(len(targets))
+	}
+
+	return &CSRGraphOf[W]{
+		directed: g.Directed(),
+		index:    index,
+		targets:  targets,
+		weights:  weights,
+		names:    names,
+		ids:      ids,
+	}
+}
+
+// VertexCount returns the number of vertices n.
+func (g *CSRGraphOf[W]) VertexCount() int {
+	return len(g.index) - 1
+}
+
+// ArcCount returns the number of stored arcs; undirected edges count twice.
+func (g *CSRGraphOf[W]) ArcCount() int {
+	return len(g.targets)
+}
+
+// ArcRange returns the half-open range of arc positions leaving u.
+func (g *CSRGraphOf[W]) ArcRange(u int32) (int, int) {
+	return int(g.index[u]), int(g.index[u+1])
+}
+
+// Target returns the head of the arc at position i.
+func (g *CSRGraphOf[W]) Target(i int) int32 {
+	return g.targets[i]
+}
+
+// ArcWeight returns the weight of the arc at position i.
+func (g *CSRGraphOf[W]) ArcWeight(i int) W {
+	if g.weights == nil {
+		return 1
+	}
+	return g.weights[i]
+}
+
+// Name returns the name of vertex u.
+func (g *CSRGraphOf[W]) Name(u int32) string {
+	if g.names == nil {
+		return strconv.Itoa(int(u))
+	}
+	return g.names[u]
+}
+
+// ID returns the index of the vertex with the given name.
+func (g *CSRGraphOf[W]) ID(name string) (int32, bool) {
+	if g.names != nil {
+		id, ok := g.ids[name]
+		return id, ok
+	}
+	id, err := strconv.Atoi(name)
+	if err != nil || id < 0 || id >= g.VertexCount() || strconv.Itoa(id) != name {
+		return 0, false
+	}
+	return int32(id), true
+}
+
+// EdgeCount returns the number of edges; for undirected graphs the two
+// arcs of an edge are counted once.
+func (g *CSRGraphOf[W]) EdgeCount() int {
+	if g.directed {
+		return g.ArcCount()
+	}
+	count := 0
+	for u := range int32(g.VertexCount()) {
+		lo, hi := g.ArcRange(u)
+		for i := lo; i < hi; i++ {
+			if u <= g.targets[i] {
+				count++
+			}
+		}
+	}
+	return count
+}
+
+func (g *CSRGraphOf[W]) HasVertex(vertex string) bool {
+	_, exists := g.ID(vertex)
+	return exists
+}
+
+// SortedVertices returns all vertex names in ascending order.
+func (g *CSRGraphOf[W]) SortedVertices() []string {
+	if g.names != nil {
+		return slices.Clone(g.names)
+	}
+	return slices.Sorted(g.AllVertices())
+}
+
+func (g *CSRGraphOf[W]) Directed() bool {
+	return g.directed
+}
+
+func (g *CSRGraphOf[W]) AllVertices() iter.Seq[string] {
+	return func(yield func(string) bool) {
+		for u := range int32(g.VertexCount()) {
+			if !yield(g.Name(u)) {
+				return
+			}
+		}
+	}
+}
+
+func (g *CSRGraphOf[W]) Neighbors(vertex string) iter.Seq[string] {
+	return func(yield func(string) bool) {
+		for v := range g.WeightedNeighbors(vertex) {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+func (g *CSRGraphOf[W]) WeightedNeighbors(vertex string) iter.Seq2[string, W] {
+	return func(yield func(string, W) bool) {
+		u, ok := g.ID(vertex)
+		if !ok {
+			return
+		}
+		lo, hi := g.ArcRange(u)
+		for i := lo; i < hi; i++ {
+			if !yield(g.Name(g.targets[i]), g.ArcWeight(i)) {
+				return
+			}
+		}
+	}
+}
+
+func (g *CSRGraphOf[W]) Degree(vertex string) int {
+	u, ok := g.ID(vertex)
+	if !ok {
+		return 0
+	}
+	lo, hi := g.ArcRange(u)
+	return hi - lo
+}
+
+func (g *CSRGraphOf[W]) HasEdge(vertex1, vertex2 string) bool {
+	_, exists := g.GetEdgeWeight(vertex1, vertex2)
+	return exists
+}
+
+func (g *CSRGraphOf[W]) GetEdgeWeight(vertex1, vertex2 string) (W, bool) {
+	u, ok := g.ID(vertex1)
+	if !ok {
+		return 0, false
+	}
+	v, ok := g.ID(vertex2)
+	if !ok {
+		return 0, false
+	}
+	lo, hi := g.ArcRange(u)
+	for i := lo; i < hi; i++ {
+		if g.targets[i] == v {
+			return g.ArcWeight(i), true
+		}
+	}
+	return 0, false
+}
+
+// Edges yields every arc of a directed graph, or every edge of an
+// undirected graph once as a (u, v) pair with u <= v.
+func (g *CSRGraphOf[W]) Edges() iter.Seq2[string, string] {
+	return func(yield func(string, string) bool) {
+		for e := range g.WeightedEdges() {
+			if !yield(e.U, e.V) {
+				return
+			}
+		}
+	}
+}
+
+// WeightedEdges yields the same edges as Edges together with their weights.
+func (g *CSRGraphOf[W]) WeightedEdges() iter.Seq[WeightedEdgeOf[string, W]] {
+	return func(yield func(WeightedEdgeOf[string, W]) bool) {
+		for u := range int32(g.VertexCount()) {
+			lo, hi := g.ArcRange(u)
+			for i := lo; i < hi; i++ {
+				v := g.targets[i]
+				if !g.directed && u > v {
+					continue
+				}
+				if !yield(WeightedEdgeOf[string, W]{g.Name(u), g.Name(v), g.ArcWeight(i)}) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// IncidentEdges yields (vertex, u) for every arc from vertex to u.
+func (g *CSRGraphOf[W]) IncidentEdges(vertex string) iter.Seq2[string, string] {
+	return func(yield func(string, string) bool) {
+		for v := range g.WeightedNeighbors(vertex) {
+			if !yield(vertex, v) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,147 @@
+package graphs
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestNewCSRGraph(t *testing.T) {
+	// 0 -- 1 -- 2
+	index := []int32{0, 1, 3, 4}
+	targets := []int32{1, 0, 2, 1}
+	weights := []int{5, 5, 7, 7}
+
+	graph, err := NewCSRGraph(index, targets, weights, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if graph.VertexCount() != 3 {
+		t.Errorf("Expected 3 vertices, got %d", graph.VertexCount())
+	}
+	if graph.ArcCount() != 4 {
+		t.Errorf("Expected 4 arcs, got %d", graph.ArcCount())
+	}
+
+	weight, exists := graph.GetEdgeWeight("1", "2")
+	if !exists || weight != 7 {
+		t.Errorf("Expected edge between 1 and 2 with weight 7, but got %d", weight)
+	}
+	if graph.HasEdge("0", "2") {
+		t.Errorf("Expected no edge between 0 and 2")
+	}
+	if graph.Degree("1") != 2 {
+		t.Errorf("Expected degree 2 for vertex 1, got %d", graph.Degree("1"))
+	}
+	if _, ok := graph.ID("01"); ok {
+		t.Errorf("Expected 01 not to name a vertex")
+	}
+}
+
+func TestNewCSRGraphRejectsInvalidArrays(t *testing.T) {
+	if _, err := NewCSRGraph([]int32{0, 2}, []int32{0}, nil, true); err == nil {
+		t.Errorf("Expected error for index pointing past the arcs")
+	}
+	if _, err := NewCSRGraph([]int32{0, 1}, []int32{3}, nil, true); err == nil {
+		t.Errorf("Expected error for out of range target")
+	}
+	if _, err := NewCSRGraph([]int32{0, 1}, []int32{0}, []int{1, 2}, true); err == nil {
+		t.Errorf("Expected error for mismatched weights")
+	}
+}
+
+func TestToCSRFromWeightedGraph(t *testing.T) {
+	graph := NewWeightedGraph()
+	graph.AddEdge("B", "A", 10)
+	graph.AddEdge("A", "C", 5)
+
+	csr := ToCSR(graph)
+	if !slices.Equal(slices.Collect(csr.AllVertices()), []string{"A", "B", "C"}) {
+		t.Errorf("Expected vertices in sorted order, got %v", slices.Collect(csr.AllVertices()))
+	}
+
+	a, _ := csr.ID("A")
+	lo, hi := csr.ArcRange(a)
+	var neighbors []string
+	var weights []int
+	for i := lo; i < hi; i++ {
+		neighbors = append(neighbors, csr.Name(csr.Target(i)))
+		weights = append(weights, csr.ArcWeight(i))
+	}
+	if !slices.Equal(neighbors, []string{"B", "C"}) || !slices.Equal(weights, []int{10, 5}) {
+		t.Errorf("Expected arcs A->B(10), A->C(5), got %v %v", neighbors, weights)
+	}
+	if ToCSR(csr) != csr {
+		t.Errorf("Expected ToCSR to return a CSRGraph unchanged")
+	}
+}
+
+func TestToCSROfFloatWeights(t *testing.T) {
+	graph := NewWeightedGraphOf[string, float64]()
+	graph.AddEdge("A", "B", 0.5)
+	graph.AddEdge("B", "C", 2.25)
+
+	csr := ToCSROf[float64](graph)
+	if w, ok := csr.GetEdgeWeight("C", "B"); !ok || w != 2.25 {
+		t.Errorf("Expected weight 2.25 for edge B-C, got %v", w)
+	}
+	if ToCSROf[float64](csr) != csr {
+		t.Errorf("Expected ToCSROf to return a CSRGraphOf unchanged")
+	}
+	// Float weights are not int weights, so ToCSR sees an unweighted graph.
+	if w, _ := ToCSR(graph).GetEdgeWeight("A", "B"); w != 1 {
+		t.Errorf("Expected weight 1 from ToCSR, got %d", w)
+	}
+}
+
+func TestToCSRFromMultiGraph(t *testing.T) {
+	graph := NewMultiGraph()
+	graph.AddEdge("A", "B")
+	graph.AddEdge("A", "B")
+
+	csr := ToCSR(graph)
+	if csr.ArcCount() != 4 {
+		t.Errorf("Expected parallel edges to become 4 arcs, got %d", csr.ArcCount())
+	}
+	if csr.Degree("A") != 2 {
+		t.Errorf("Expected degree 2 for A, got %d", csr.Degree("A"))
+	}
+}
+
+func TestCountsInCSRGraph(t *testing.T) {
+	graph := NewBasicGraph()
+	graph.AddVertex("D")
+	graph.AddEdge("A", "B")
+	graph.AddEdge("B", "C")
+
+	csr := ToCSR(graph)
+	if csr.VertexCount() != 4 || csr.EdgeCount() != 2 {
+		t.Errorf("Expected 4 vertices and 2 edges, got %d and %d", csr.VertexCount(), csr.EdgeCount())
+	}
+	if !csr.HasVertex("D") || csr.HasVertex("E") {
+		t.Errorf("Expected D to be a vertex and E not to be")
+	}
+}
+
+func TestToCSRWithSelfLoops(t *testing.T) {
+	basic := NewBasicGraph()
+	basic.AddEdge("A", "B")
+	basic.AddEdge("A", "A")
+
+	multi := NewMultiGraph()
+	multi.AddEdge("A", "B")
+	multi.AddEdge("A", "A")
+	multi.AddEdge("A", "A")
+
+	for name, graph := range map[string]Multi{"basic": basic, "multi": multi} {
+		t.Run(name, func(t *testing.T) {
+			loops := graph.Multiplicity("A", "A")
+			csr := ToCSR(graph)
+			if csr.ArcCount() != 2+loops {
+				t.Errorf("Expected one arc per self-loop, got %d arcs for %d loops", csr.ArcCount(), loops)
+			}
+			if n := graph.EdgeCount(); csr.EdgeCount() != n || n != 1+loops {
+				t.Errorf("Expected %d edges, got %d and %d in CSR", 1+loops, n, csr.EdgeCount())
+			}
+		})
+	}
+}
@@ -1,32 +1,205 @@
 package graphs
 
+import (
+	"iter"
+	"maps"
+	"slices"
+)
+
+// DirectedGraph is a directed graph.
+//
+// Adjacency is kept in insertion-ordered sets, so HasEdge and RemoveEdge
+// take constant time.
 type DirectedGraph struct {
-	Vertices map[string][]string
+	adj    map[string]*neighborSet
+	policy EdgePolicy
+	attrs  *Attributes
 }
 
+// NewDirectedGraph creates a graph that allows duplicate edges and self-loops.
 func NewDirectedGraph() *DirectedGraph {
+	return NewDirectedGraphWithPolicy(EdgePolicy{})
+}
+
+// NewDirectedGraphWithPolicy creates a graph whose AddEdge follows policy.
+func NewDirectedGraphWithPolicy(policy EdgePolicy) *DirectedGraph {
 	return &DirectedGraph{
-		Vertices: make(map[string][]string),
+		adj:    make(map[string]*neighborSet),
+		policy: policy,
+	}
+}
+
+// Policy returns the policy AddEdge follows for duplicate edges and
+// self-loops.
+func (g *DirectedGraph) Policy() EdgePolicy {
+	return g.policy
+}
+
+// Attrs returns the vertex and edge attributes of the graph.
+func (g *DirectedGraph) Attrs() *Attributes {
+	return lazyAttrs(&g.attrs, true)
+}
+
+func (g *DirectedGraph) storedAttrs() *Attributes {
+	return g.attrs
+}
+
+// AdjacencyLists returns the out-neighbor lists of the graph, repeating a neighbor
+// once per parallel edge. The result is a snapshot: changing it does not
+// change the graph.
+func (g *DirectedGraph) AdjacencyLists() map[string][]string {
+	res := make(map[string][]string, len(g.adj))
+	for v, neighbors := range g.adj {
+		res[v] = neighbors.list()
 	}
+	return res
 }
 
-func (g *DirectedGraph) AddEdge(vertex1, vertex2 string) {
-	g.Vertices[vertex1] = append(g.Vertices[vertex1], vertex2)
+// Vertices returns the same snapshot as AdjacencyLists.
+//
+// Deprecated: Vertices used to be an exported field that could be written
+// through; use AdjacencyLists, or AddEdge and RemoveEdge to change the graph.
+func (g *DirectedGraph) Vertices() map[string][]string {
+	return g.AdjacencyLists()
 }
 
+// AddEdge adds an edge unless the policy forbids it and reports what happened.
+func (g *DirectedGraph) AddEdge(vertex1, vertex2 string) EdgeStatus {
+	if status := g.policy.check(g, vertex1, vertex2); !status.Added() {
+		return status
+	}
+	g.AddVertex(vertex1)
+	g.AddVertex(vertex2)
+	g.adj[vertex1].add(vertex2)
+	return EdgeAdded
+}
+
+// RemoveEdge removes one edge from vertex1 to vertex2.
 func (g *DirectedGraph) RemoveEdge(vertex1, vertex2 string) {
-	g.Vertices[vertex1] = removeElement(g.Vertices[vertex1], vertex2)
+	if neighbors, exists := g.adj[vertex1]; exists {
+		neighbors.remove(vertex2)
+	}
+	if g.attrs != nil && !g.HasEdge(vertex1, vertex2) {
+		g.attrs.removeEdge(vertex1, vertex2)
+	}
 }
 
+// GetNeighbors returns a copy of the out-neighbor list of vertex, repeating
+// a neighbor once per parallel edge.
 func (g *DirectedGraph) GetNeighbors(vertex string) []string {
-	return g.Vertices[vertex]
+	neighbors, exists := g.adj[vertex]
+	if !exists {
+		return nil
+	}
+	return neighbors.list()
 }
 
 func (g *DirectedGraph) HasEdge(vertex1, vertex2 string) bool {
-	for _, v := range g.Vertices[vertex1] {
-		if v == vertex2 {
-			return true
+	neighbors, exists := g.adj[vertex1]
+	return exists && neighbors.has(vertex2)
+}
+
+// Multiplicity returns the number of parallel edges from vertex1 to vertex2.
+func (g *DirectedGraph) Multiplicity(vertex1, vertex2 string) int {
+	neighbors, exists := g.adj[vertex1]
+	if !exists {
+		return 0
+	}
+	return neighbors.count(vertex2)
+}
+
+func (g *DirectedGraph) Directed() bool {
+	return true
+}
+
+func (g *DirectedGraph) AllVertices() iter.Seq[string] {
+	return maps.Keys(g.adj)
+}
+
+// Neighbors yields every out-neighbor once, in the order the edges were added.
+func (g *DirectedGraph) Neighbors(vertex string) iter.Seq[string] {
+	neighbors, exists := g.adj[vertex]
+	if !exists {
+		return func(func(string) bool) {}
+	}
+	return neighbors.all()
+}
+
+// Degree returns the out-degree, counting parallel edges with their multiplicity.
+func (g *DirectedGraph) Degree(vertex string) int {
+	neighbors, exists := g.adj[vertex]
+	if !exists {
+		return 0
+	}
+	return neighbors.total()
+}
+
+// AddVertex adds an isolated vertex; an existing vertex is left unchanged.
+func (g *DirectedGraph) AddVertex(vertex string) {
+	if _, exists := g.adj[vertex]; !exists {
+		g.adj[vertex] = newNeighborSet()
+	}
+}
+
+// RemoveVertex removes vertex together with all incoming and outgoing edges.
+func (g *DirectedGraph) RemoveVertex(vertex string) {
+	delete(g.adj, vertex)
+	for _, neighbors := range g.adj {
+		neighbors.removeAll(vertex)
+	}
+	if g.attrs != nil {
+		g.attrs.removeVertex(vertex)
+	}
+}
+
+func (g *DirectedGraph) HasVertex(vertex string) bool {
+	_, exists := g.adj[vertex]
+	return exists
+}
+
+func (g *DirectedGraph) VertexCount() int {
+	return len(g.adj)
+}
+
+func (g *DirectedGraph) EdgeCount() int {
+	count := 0
+	for _, neighbors := range g.adj {
+		count += neighbors.total()
+	}
+	return count
+}
+
+// SortedVertices returns all vertices in ascending order.
+func (g *DirectedGraph) SortedVertices() []string {
+	return slices.Sorted(maps.Keys(g.adj))
+}
+
+// Edges yields every edge as a (from, to) pair, parallel edges once each.
+func (g *DirectedGraph) Edges() iter.Seq2[string, string] {
+	return func(yield func(string, string) bool) {
+		for u := range g.adj {
+			for _, v := range g.IncidentEdges(u) {
+				if !yield(u, v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// IncidentEdges yields (vertex, u) once for every edge from vertex to u.
+func (g *DirectedGraph) IncidentEdges(vertex string) iter.Seq2[string, string] {
+	return func(yield func(string, string) bool) {
+		neighbors, exists := g.adj[vertex]
+		if !exists {
+			return
+		}
+		for v := range neighbors.all() {
+			for range neighbors.count(v) {
+				if !yield(vertex, v) {
+					return
+				}
+			}
 		}
 	}
-	return false
 }
@@ -1,6 +1,7 @@
 package graphs
 
 import (
+	"slices"
 	"testing"
 )
 
@@ -62,3 +63,28 @@ func TestHasEdgeInDirectedGraph(t *testing.T) {
 		t.Errorf("Expected no edge from A to C")
 	}
 }
+
+func TestVertexOperationsInDirectedGraph(t *testing.T) {
+	graph := NewDirectedGraph()
+	graph.AddEdge("A", "B")
+	graph.AddEdge("C", "A")
+	graph.AddVertex("D")
+
+	if !graph.HasVertex("B") {
+		t.Errorf("Expected sink vertex B to be recorded")
+	}
+	if graph.VertexCount() != 4 || graph.EdgeCount() != 2 {
+		t.Errorf("Expected 4 vertices and 2 edges, got %d and %d", graph.VertexCount(), graph.EdgeCount())
+	}
+
+	graph.RemoveVertex("A")
+	if graph.HasEdge("C", "A") {
+		t.Errorf("Expected incoming edge C -> A to be removed")
+	}
+	if graph.EdgeCount() != 0 {
+		t.Errorf("Expected no edges, got %d", graph.EdgeCount())
+	}
+	if !slices.Equal(graph.SortedVertices(), []string{"B", "C", "D"}) {
+		t.Errorf("Expected vertices [B C D], got %v", graph.SortedVertices())
+	}
+}
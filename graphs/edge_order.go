@@ -0,0 +1,36 @@
+package graphs
+
+import (
+	"cmp"
+	"math/rand/v2"
+	"slices"
+)
+
+// CompareWeightedEdges orders edges by weight, then by U, then by V.
+// This is the canonical edge order; it is total for graphs without
+// parallel edges.
+func CompareWeightedEdges[N Vertex, W Weight](a, b WeightedEdgeOf[N, W]) int {
+	if c := cmp.Compare(a.Weight, b.Weight); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(a.U, b.U); c != 0 {
+		return c
+	}
+	return cmp.Compare(a.V, b.V)
+}
+
+// SortWeightedEdges sorts edges in place into the canonical order.
+func SortWeightedEdges[N Vertex, W Weight](edges []WeightedEdgeOf[N, W]) {
+	slices.SortFunc(edges, CompareWeightedEdges[N, W])
+}
+
+// ShuffleWeightedEdges puts edges in place into a pseudo-random order
+// determined only by the seed and the set of edges: the same edges and
+// seed always give the same order, whatever order they came in.
+func ShuffleWeightedEdges[N Vertex, W Weight](edges []WeightedEdgeOf[N, W], seed uint64) {
+	SortWeightedEdges(edges)
+	r := rand.New(rand.NewPCG(seed, seed))
+	r.Shuffle(len(edges), func(i, j int) {
+		edges[i], edges[j] = edges[j], edges[i]
+	})
+}
@@ -0,0 +1,58 @@
+package graphs
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSortedEdgesInWeightedGraph(t *testing.T) {
+	graph := NewWeightedGraph()
+	graph.AddEdge("D", "C", 2)
+	graph.AddEdge("B", "A", 2)
+	graph.AddEdge("C", "A", 1)
+	graph.AddEdge("B", "D", 2)
+
+	expected := []WeightedEdge{
+		{U: "A", V: "C", Weight: 1},
+		{U: "A", V: "B", Weight: 2},
+		{U: "B", V: "D", Weight: 2},
+		{U: "C", V: "D", Weight: 2},
+	}
+	for range 10 {
+		if edges := graph.SortedEdges(); !slices.Equal(edges, expected) {
+			t.Fatalf("Expected edges %v, got %v", expected, edges)
+		}
+	}
+}
+
+func TestShuffledEdgesInWeightedGraph(t *testing.T) {
+	graph := NewWeightedGraph()
+	for i, v := range []string{"B", "C", "D", "E", "F", "G", "H"} {
+		graph.AddEdge("A", v, i)
+	}
+
+	first := graph.ShuffledEdges(42)
+	for range 10 {
+		if edges := graph.ShuffledEdges(42); !slices.Equal(edges, first) {
+			t.Fatalf("Expected the same order for the same seed, got %v and %v", first, edges)
+		}
+	}
+
+	sorted := slices.Clone(first)
+	SortWeightedEdges(sorted)
+	if !slices.Equal(sorted, graph.SortedEdges()) {
+		t.Errorf("Expected a permutation of all edges, got %v", first)
+	}
+}
+
+func TestShuffleWeightedEdgesIgnoresInputOrder(t *testing.T) {
+	a := []WeightedEdge{{"A", "B", 1}, {"B", "C", 2}, {"A", "C", 3}, {"C", "D", 1}}
+	b := slices.Clone(a)
+	slices.Reverse(b)
+
+	ShuffleWeightedEdges(a, 7)
+	ShuffleWeightedEdges(b, 7)
+	if !slices.Equal(a, b) {
+		t.Errorf("Expected equal orders, got %v and %v", a, b)
+	}
+}
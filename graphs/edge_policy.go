@@ -0,0 +1,97 @@
+package graphs
+
+import "errors"
+
+var (
+	ErrDuplicateEdge = errors.New("duplicate edge")
+	ErrSelfLoop      = errors.New("self-loop")
+)
+
+// EdgeAction says what AddEdge does with a duplicate edge or a self-loop.
+type EdgeAction int
+
+const (
+	// AllowEdge inserts the edge anyway.
+	AllowEdge EdgeAction = iota
+	// IgnoreEdge silently leaves the graph unchanged.
+	IgnoreEdge
+	// RejectEdge leaves the graph unchanged and reports an error.
+	RejectEdge
+)
+
+// EdgePolicy configures how BasicGraph and DirectedGraph treat duplicate
+// edges and self-loops. The zero value allows both.
+type EdgePolicy struct {
+	Duplicates EdgeAction
+	SelfLoops  EdgeAction
+}
+
+// SimplePolicy keeps a graph simple by ignoring duplicates and self-loops.
+var SimplePolicy = EdgePolicy{Duplicates: IgnoreEdge, SelfLoops: IgnoreEdge}
+
+// StrictPolicy keeps a graph simple by rejecting duplicates and self-loops.
+var StrictPolicy = EdgePolicy{Duplicates: RejectEdge, SelfLoops: RejectEdge}
+
+// EdgeStatus reports what AddEdge did.
+type EdgeStatus int
+
+const (
+	EdgeAdded EdgeStatus = iota
+	DuplicateIgnored
+	DuplicateRejected
+	SelfLoopIgnored
+	SelfLoopRejected
+)
+
+// Added reports whether the edge was inserted.
+func (s EdgeStatus) Added() bool {
+	return s == EdgeAdded
+}
+
+// Err returns ErrDuplicateEdge or ErrSelfLoop for rejected edges and nil otherwise.
+func (s EdgeStatus) Err() error {
+	switch s {
+	case DuplicateRejected:
+		return ErrDuplicateEdge
+	case SelfLoopRejected:
+		return ErrSelfLoop
+	}
+	return nil
+}
+
+func (s EdgeStatus) String() string {
+	switch s {
+	case EdgeAdded:
+		return "added"
+	case DuplicateIgnored:
+		return "duplicate ignored"
+	case DuplicateRejected:
+		return "duplicate rejected"
+	case SelfLoopIgnored:
+		return "self-loop ignored"
+	case SelfLoopRejected:
+		return "self-loop rejected"
+	}
+	return "unknown"
+}
+
+// check decides whether the edge vertex1 -> vertex2 may be added to g.
+func (p EdgePolicy) check(g Graph, vertex1, vertex2 string) EdgeStatus {
+	if vertex1 == vertex2 {
+		switch p.SelfLoops {
+		case IgnoreEdge:
+			return SelfLoopIgnored
+		case RejectEdge:
+			return SelfLoopRejected
+		}
+	}
+	if g.HasEdge(vertex1, vertex2) {
+		switch p.Duplicates {
+		case IgnoreEdge:
+			return DuplicateIgnored
+		case RejectEdge:
+			return DuplicateRejected
+		}
+	}
+	return EdgeAdded
+}
@@ -0,0 +1,63 @@
+package graphs
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestDefaultPolicyAllowsDuplicatesAndSelfLoops(t *testing.T) {
+	graph := NewBasicGraph()
+	if status := graph.AddEdge("A", "B"); status != EdgeAdded {
+		t.Errorf("Expected edge to be added, got %v", status)
+	}
+	if status := graph.AddEdge("A", "B"); status != EdgeAdded {
+		t.Errorf("Expected duplicate edge to be added, got %v", status)
+	}
+	if status := graph.AddEdge("A", "A"); status != EdgeAdded {
+		t.Errorf("Expected self-loop to be added, got %v", status)
+	}
+	if graph.EdgeCount() != 3 {
+		t.Errorf("Expected 3 edges, got %d", graph.EdgeCount())
+	}
+}
+
+func TestIgnorePolicyInBasicGraph(t *testing.T) {
+	graph := NewBasicGraphWithPolicy(SimplePolicy)
+	graph.AddEdge("A", "B")
+
+	if status := graph.AddEdge("B", "A"); status != DuplicateIgnored {
+		t.Errorf("Expected duplicate to be ignored, got %v", status)
+	}
+	if status := graph.AddEdge("A", "A"); status != SelfLoopIgnored {
+		t.Errorf("Expected self-loop to be ignored, got %v", status)
+	}
+	if status := graph.AddEdge("A", "A"); status.Err() != nil {
+		t.Errorf("Expected no error for an ignored edge, got %v", status.Err())
+	}
+	if graph.EdgeCount() != 1 || graph.Degree("A") != 1 {
+		t.Errorf("Expected a single edge, got %d edges and degree %d", graph.EdgeCount(), graph.Degree("A"))
+	}
+}
+
+func TestRejectPolicyInDirectedGraph(t *testing.T) {
+	graph := NewDirectedGraphWithPolicy(StrictPolicy)
+	graph.AddEdge("A", "B")
+
+	if status := graph.AddEdge("B", "A"); status != EdgeAdded {
+		t.Errorf("Expected reverse edge to be added, got %v", status)
+	}
+	status := graph.AddEdge("A", "B")
+	if status != DuplicateRejected || !errors.Is(status.Err(), ErrDuplicateEdge) {
+		t.Errorf("Expected duplicate to be rejected, got %v", status)
+	}
+	status = graph.AddEdge("C", "C")
+	if status != SelfLoopRejected || !errors.Is(status.Err(), ErrSelfLoop) {
+		t.Errorf("Expected self-loop to be rejected, got %v", status)
+	}
+	if graph.HasVertex("C") {
+		t.Errorf("Expected a rejected edge to leave the graph unchanged")
+	}
+	if graph.EdgeCount() != 2 {
+		t.Errorf("Expected 2 edges, got %d", graph.EdgeCount())
+	}
+}
@@ -0,0 +1,84 @@
+// Package generators builds synthetic graphs for tests and experiments.
+//
+// Every generator returns an EdgeList over the vertices 0..n-1 and is
+// driven by a seed, so the same arguments always give the same graph.
+// An EdgeList is turned into a graphs.BasicGraph, graphs.MultiGraph or
+// graphs.WeightedGraph whose vertices are named by their decimal indices,
+// like the vertices of the ECL files.
+package generators
+
+import (
+	"math/rand/v2"
+	"strconv"
+
+	"github.com/Salvatore112/graph_analysis_algorithms/graphs"
+)
+
+// PositionKey is the vertex attribute that holds the coordinates of the
+// vertices of geometric graphs.
+var PositionKey = graphs.NewKey[[2]float64]("position")
+
+// EdgeList is an undirected graph on the vertices 0..N-1.
+type EdgeList struct {
+	N     int
+	Edges [][2]int
+	// Positions holds vertex coordinates for geometric graphs, nil otherwise.
+	Positions [][2]float64
+}
+
+func newRand(seed uint64) *rand.Rand {
+	return rand.New(rand.NewPCG(seed, seed))
+}
+
+// Name returns the name of vertex i in the generated graphs.
+func Name(i int) string {
+	return strconv.Itoa(i)
+}
+
+func (l *EdgeList) setPositions(attrs *graphs.Attributes) {
+	for i, p := range l.Positions {
+		graphs.SetVertexAttr(attrs, Name(i), PositionKey, p)
+	}
+}
+
+// Basic builds a BasicGraph with one edge per entry of Edges.
+func (l *EdgeList) Basic() *graphs.BasicGraph {
+	g := graphs.NewBasicGraph()
+	for i := range l.N {
+		g.AddVertex(Name(i))
+	}
+	for _, e := range l.Edges {
+		g.AddEdge(Name(e[0]), Name(e[1]))
+	}
+	l.setPositions(g.Attrs())
+	return g
+}
+
+// Multi builds a MultiGraph; repeated entries of Edges become parallel edges.
+func (l *EdgeList) Multi() *graphs.MultiGraph {
+	g := graphs.NewMultiGraph()
+	for i := range l.N {
+		g.AddVertex(Name(i))
+	}
+	for _, e := range l.Edges {
+		g.AddEdge(Name(e[0]), Name(e[1]))
+	}
+	l.setPositions(g.Attrs())
+	return g
+}
+
+// Weighted builds a WeightedGraph whose edge weights are drawn from weights.
+// Weights are drawn in the order of Edges from a source seeded with seed;
+// of repeated entries the last one wins.
+func (l *EdgeList) Weighted(weights WeightFunc, seed uint64) *graphs.WeightedGraph {
+	r := newRand(seed)
+	g := graphs.NewWeightedGraph()
+	for i := range l.N {
+		g.AddVertex(Name(i))
+	}
+	for _, e := range l.Edges {
+		g.AddEdge(Name(e[0]), Name(e[1]), weights(r, e[0], e[1]))
+	}
+	l.setPositions(g.Attrs())
+	return g
+}
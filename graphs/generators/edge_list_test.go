@@ -0,0 +1,34 @@
+package generators
+
+import (
+	"testing"
+
+	"github.com/Salvatore112/graph_analysis_algorithms/graphs"
+)
+
+func TestEdgeListConversions(t *testing.T) {
+	l := &EdgeList{N: 4, Edges: [][2]int{{0, 1}, {1, 2}, {1, 2}, {2, 2}}}
+
+	basic := l.Basic()
+	if basic.VertexCount() != 4 || !basic.HasVertex("3") || !basic.HasEdge("2", "1") {
+		t.Errorf("Unexpected basic graph %v", basic.SortedVertices())
+	}
+
+	multi := l.Multi()
+	if multi.EdgeCount() != 4 || multi.Multiplicity("1", "2") != 2 {
+		t.Errorf("Expected parallel edges in the multigraph, got %d edges", multi.EdgeCount())
+	}
+
+	weighted := l.Weighted(ConstantWeights(7), 0)
+	if w, ok := weighted.GetEdgeWeight("0", "1"); !ok || w != 7 {
+		t.Errorf("Expected weight 7, got %d (%v)", w, ok)
+	}
+}
+
+func TestPositions(t *testing.T) {
+	g := Grid(2, 3).Weighted(ConstantWeights(1), 0)
+	p, ok := graphs.VertexAttr(g.Attrs(), Name(5), PositionKey)
+	if !ok || p != [2]float64{2, 1} {
+		t.Errorf("Expected vertex 5 at (2, 1), got %v (%v)", p, ok)
+	}
+}
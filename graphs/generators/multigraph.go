@@ -0,0 +1,121 @@
+package generators
+
+// RandomMultigraph returns a random loopless multigraph on n vertices whose
+// maximum degree is exactly delta and maximum edge multiplicity exactly mu.
+// Convert it with Multi.
+//
+// If bipartite is set, every edge joins a vertex of 0..(n+1)/2-1 to one of
+// the remaining vertices. The generator first joins vertices 0 and the
+// first vertex of the other side by mu parallel edges and raises the
+// degree of vertex 0 to delta, then adds random edges while some two
+// vertices can still be joined without exceeding delta or mu. Most
+// vertices therefore end up with degree close to delta, which makes the
+// graphs hard instances for edge coloring.
+//
+// It panics if no multigraph with the given parameters exists.
+func RandomMultigraph(n, delta, mu int, bipartite bool, seed uint64) *EdgeList {
+	left := n
+	if bipartite {
+		left = (n + 1) / 2
+	}
+	// partners counts the vertices that vertex 0 may be joined to.
+	partners := n - 1
+	if bipartite {
+		partners = n - left
+	}
+	if delta < 0 || mu < 0 || mu > delta || (mu == 0) != (delta == 0) || delta > mu*partners {
+		panic("generators: RandomMultigraph needs 1 <= mu <= delta <= mu*partners, or delta = mu = 0")
+	}
+
+	r := newRand(seed)
+	l := &EdgeList{N: n, Edges: make([][2]int, 0, n*delta/2)}
+	if delta == 0 {
+		return l
+	}
+	degree := make([]int, n)
+	count := make(map[[2]int]int)
+	canJoin := func(u, v int) bool {
+		if u == v || (bipartite && (u < left) == (v < left)) {
+			return false
+		}
+		return degree[u] < delta && degree[v] < delta && count[ordered(u, v)] < mu
+	}
+	join := func(u, v int) {
+		e := ordered(u, v)
+		count[e]++
+		degree[u]++
+		degree[v]++
+		l.Edges = append(l.Edges, e)
+	}
+
+	first := 1
+	if bipartite {
+		first = left
+	}
+	for range mu {
+		join(0, first)
+	}
+	candidates := make([]int, 0, partners)
+	for v := 1; v < n; v++ {
+		if canJoin(0, v) {
+			candidates = append(candidates, v)
+		}
+	}
+	r.Shuffle(len(candidates), func(i, j int) {
+		candidates[i], candidates[j] = candidates[j], candidates[i]
+	})
+	for degree[0] < delta {
+		for _, v := range candidates {
+			if degree[0] < delta && canJoin(0, v) {
+				join(0, v)
+			}
+		}
+	}
+
+	// open holds the vertices whose degree is below delta. Random pairs are
+	// tried until many attempts in a row fail.
+	open := make([]int, 0, n)
+	for v := range n {
+		if degree[v] < delta {
+			open = append(open, v)
+		}
+	}
+	for failures := 0; len(open) > 1 && failures < 4*len(open)+16; {
+		i, j := r.IntN(len(open)), r.IntN(len(open))
+		u, v := open[i], open[j]
+		if !canJoin(u, v) {
+			failures++
+			continue
+		}
+		failures = 0
+		join(u, v)
+		// Drop saturated vertices, the one with the larger index first.
+		for _, k := range []int{max(i, j), min(i, j)} {
+			if degree[open[k]] == delta {
+				open[k] = open[len(open)-1]
+				open = open[:len(open)-1]
+			}
+		}
+	}
+	sortEdges(l.Edges)
+	return l
+}
+
+// FatTriangle returns Shannon's extremal multigraph of maximum degree delta:
+// a triangle whose sides have multiplicities ⌊delta/2⌋, ⌊delta/2⌋ and
+// ⌈delta/2⌉. All its edges are pairwise adjacent, so it needs ⌊3·delta/2⌋
+// colors, the most Shannon's bound allows.
+func FatTriangle(delta int) *EdgeList {
+	l := &EdgeList{N: 3, Edges: make([][2]int, 0, 3*delta/2)}
+	sides := [][2]int{{0, 1}, {0, 2}, {1, 2}}
+	for i, side := range sides {
+		m := delta / 2
+		if i == len(sides)-1 {
+			m = (delta + 1) / 2
+		}
+		for range m {
+			l.Edges = append(l.Edges, side)
+		}
+	}
+	return l
+}
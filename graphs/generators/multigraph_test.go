@@ -0,0 +1,74 @@
+package generators
+
+import "testing"
+
+func checkMultigraph(t *testing.T, l *EdgeList, delta, mu int, bipartite bool) {
+	t.Helper()
+	g := l.Multi()
+	maxDegree, maxMu := 0, 0
+	for v := range g.AllVertices() {
+		maxDegree = max(maxDegree, g.Degree(v))
+	}
+	for _, e := range l.Edges {
+		if e[0] == e[1] {
+			t.Fatalf("Unexpected loop at %d", e[0])
+		}
+		if bipartite && (e[0] < (l.N+1)/2) == (e[1] < (l.N+1)/2) {
+			t.Fatalf("Edge %v inside one side", e)
+		}
+		maxMu = max(maxMu, g.Multiplicity(Name(e[0]), Name(e[1])))
+	}
+	if maxDegree != delta || maxMu != mu {
+		t.Fatalf("Expected Δ = %d and μ = %d, got %d and %d", delta, mu, maxDegree, maxMu)
+	}
+}
+
+func TestRandomMultigraph(t *testing.T) {
+	tests := []struct {
+		n, delta, mu int
+		bipartite    bool
+	}{
+		{2, 5, 5, false},
+		{3, 4, 2, false},
+		{10, 7, 3, false},
+		{50, 12, 1, false},
+		{10, 9, 3, true},
+		{41, 6, 6, true},
+		{5, 0, 0, false},
+	}
+	for _, tt := range tests {
+		for seed := range uint64(5) {
+			checkMultigraph(t, RandomMultigraph(tt.n, tt.delta, tt.mu, tt.bipartite, seed), tt.delta, tt.mu, tt.bipartite)
+		}
+	}
+}
+
+func TestRandomMultigraphIsDense(t *testing.T) {
+	l := RandomMultigraph(100, 8, 2, false, 1)
+	if len(l.Edges) < 90*8/2 {
+		t.Errorf("Expected most vertices to reach Δ, got %d edges", len(l.Edges))
+	}
+}
+
+func TestRandomMultigraphImpossible(t *testing.T) {
+	for _, args := range [][3]int{{3, 5, 2}, {4, 2, 3}, {4, 2, 0}} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Expected a panic for n, Δ, μ = %v", args)
+				}
+			}()
+			RandomMultigraph(args[0], args[1], args[2], false, 0)
+		}()
+	}
+}
+
+func TestFatTriangle(t *testing.T) {
+	for delta := 1; delta <= 7; delta++ {
+		l := FatTriangle(delta)
+		if len(l.Edges) != 3*delta/2 {
+			t.Fatalf("Δ = %d: expected %d edges, got %d", delta, 3*delta/2, len(l.Edges))
+		}
+		checkMultigraph(t, l, delta, (delta+1)/2, false)
+	}
+}
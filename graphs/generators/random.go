@@ -0,0 +1,391 @@
+package generators
+
+import (
+	"cmp"
+	"math"
+	"math/rand/v2"
+	"slices"
+)
+
+func sortEdges(edges [][2]int) {
+	slices.SortFunc(edges, func(a, b [2]int) int {
+		return cmp.Or(cmp.Compare(a[0], b[0]), cmp.Compare(a[1], b[1]))
+	})
+}
+
+func ordered(u, v int) [2]int {
+	if u > v {
+		u, v = v, u
+	}
+	return [2]int{u, v}
+}
+
+// GNP returns an Erdős–Rényi G(n, p) graph: every pair of vertices is
+// joined independently with probability p.
+//
+// Instead of tossing a coin for every pair the generator jumps over the
+// absent edges (Batagelj and Brandes), so sparse graphs take O(n + m) time.
+func GNP(n int, p float64, seed uint64) *EdgeList {
+	if p < 0 || p > 1 {
+		panic("generators: GNP needs 0 <= p <= 1")
+	}
+	if p == 1 {
+		return Complete(n)
+	}
+	l := &EdgeList{N: n, Edges: make([][2]int, 0)}
+	if p == 0 {
+		return l
+	}
+	r := newRand(seed)
+	logQ := math.Log1p(-p)
+	for v, w := 1, -1; v < n; {
+		skip := math.Log1p(-r.Float64()) / logQ
+		w += 1 + int(min(skip, float64(n)*float64(n)))
+		for w >= v && v < n {
+			w -= v
+			v++
+		}
+		if v < n {
+			l.Edges = append(l.Edges, [2]int{w, v})
+		}
+	}
+	return l
+}
+
+// GNM returns an Erdős–Rényi G(n, m) graph: m edges chosen uniformly among
+// all pairs of vertices. The edges are sorted.
+func GNM(n, m int, seed uint64) *EdgeList {
+	pairs := n * (n - 1) / 2
+	if m < 0 || m > pairs {
+		panic("generators: GNM needs 0 <= m <= n(n-1)/2")
+	}
+	r := newRand(seed)
+	l := &EdgeList{N: n, Edges: make([][2]int, 0, m)}
+	if 2*m > pairs {
+		// Dense graphs: take m pairs of a random permutation of all pairs.
+		all := Complete(n).Edges
+		for i := range m {
+			j := i + r.IntN(len(all)-i)
+			all[i], all[j] = all[j], all[i]
+		}
+		l.Edges = append(l.Edges, all[:m]...)
+	} else {
+		chosen := make(map[[2]int]struct{}, m)
+		for len(l.Edges) < m {
+			u, v := r.IntN(n), r.IntN(n)
+			if u == v {
+				continue
+			}
+			e := ordered(u, v)
+			if _, exists := chosen[e]; !exists {
+				chosen[e] = struct{}{}
+				l.Edges = append(l.Edges, e)
+			}
+		}
+	}
+	sortEdges(l.Edges)
+	return l
+}
+
+// RandomRegular returns a random simple d-regular graph on n vertices; n*d
+// must be even and d < n.
+//
+// It uses the pairing algorithm of Steger and Wormald, which restarts when
+// the remaining stubs cannot be matched; for d much smaller than n the
+// distribution is close to uniform.
+func RandomRegular(n, d int, seed uint64) *EdgeList {
+	if d < 0 || (d >= n && n > 0) || n*d%2 != 0 {
+		panic("generators: RandomRegular needs 0 <= d < n and n*d even")
+	}
+	r := newRand(seed)
+	for {
+		if edges := tryRegular(n, d, r); edges != nil {
+			return &EdgeList{N: n, Edges: edges}
+		}
+	}
+}
+
+func tryRegular(n, d int, r *rand.Rand) [][2]int {
+	edges := make([][2]int, 0, n*d/2)
+	chosen := make(map[[2]int]struct{}, n*d/2)
+	stubs := make([]int, 0, n*d)
+	for v := range n {
+		for range d {
+			stubs = append(stubs, v)
+		}
+	}
+	for len(stubs) > 0 {
+		for i := len(stubs) - 1; i > 0; i-- {
+			j := r.IntN(i + 1)
+			stubs[i], stubs[j] = stubs[j], stubs[i]
+		}
+		potential := make(map[int]int)
+		for i := 0; i < len(stubs); i += 2 {
+			e := ordered(stubs[i], stubs[i+1])
+			if _, exists := chosen[e]; e[0] != e[1] && !exists {
+				chosen[e] = struct{}{}
+				edges = append(edges, e)
+			} else {
+				potential[e[0]]++
+				potential[e[1]]++
+			}
+		}
+		// The leftover stubs must still be matchable by some new edge.
+		left := slices.Sorted(func(yield func(int) bool) {
+			for v := range potential {
+				if !yield(v) {
+					return
+				}
+			}
+		})
+		if len(left) > 0 && !hasFreePair(left, chosen) {
+			return nil
+		}
+		stubs = stubs[:0]
+		for _, v := range left {
+			for range potential[v] {
+				stubs = append(stubs, v)
+			}
+		}
+	}
+	sortEdges(edges)
+	return edges
+}
+
+func hasFreePair(vertices []int, chosen map[[2]int]struct{}) bool {
+	for i, u := range vertices {
+		for _, v := range vertices[i+1:] {
+			if _, exists := chosen[[2]int{u, v}]; !exists {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// BarabasiAlbert returns a preferential attachment graph: starting from a
+// star on m+1 vertices, every new vertex is joined to m distinct existing
+// vertices chosen with probability proportional to their degree.
+func BarabasiAlbert(n, m int, seed uint64) *EdgeList {
+	if m < 1 || m >= n {
+		panic("generators: BarabasiAlbert needs 1 <= m < n")
+	}
+	r := newRand(seed)
+	l := &EdgeList{N: n, Edges: make([][2]int, 0, m*(n-m))}
+	// Every vertex appears in repeated once per incident edge.
+	repeated := make([]int, 0, 2*m*(n-m))
+	for v := 1; v <= m; v++ {
+		l.Edges = append(l.Edges, [2]int{0, v})
+		repeated = append(repeated, 0, v)
+	}
+	targets := make([]int, 0, m)
+	chosen := make(map[int]struct{}, m)
+	for source := m + 1; source < n; source++ {
+		targets, chosen = targets[:0], make(map[int]struct{}, m)
+		for len(targets) < m {
+			t := repeated[r.IntN(len(repeated))]
+			if _, exists := chosen[t]; !exists {
+				chosen[t] = struct{}{}
+				targets = append(targets, t)
+			}
+		}
+		slices.Sort(targets)
+		for _, t := range targets {
+			l.Edges = append(l.Edges, [2]int{t, source})
+			repeated = append(repeated, t, source)
+		}
+	}
+	return l
+}
+
+// WattsStrogatz returns a small-world graph: a ring where every vertex is
+// joined to its k nearest neighbors (k even, k < n), after which every
+// edge (u, u+j) is replaced with probability p by an edge from u to a
+// uniformly chosen vertex it is not yet adjacent to. The edges are sorted.
+func WattsStrogatz(n, k int, p float64, seed uint64) *EdgeList {
+	if k%2 != 0 || k < 0 || (k >= n && n > 0) {
+		panic("generators: WattsStrogatz needs an even k with 0 <= k < n")
+	}
+	if p < 0 || p > 1 {
+		panic("generators: WattsStrogatz needs 0 <= p <= 1")
+	}
+	r := newRand(seed)
+	adj := make([]map[int]struct{}, n)
+	for v := range n {
+		adj[v] = make(map[int]struct{}, k)
+	}
+	link := func(u, v int) {
+		adj[u][v] = struct{}{}
+		adj[v][u] = struct{}{}
+	}
+	for j := 1; j <= k/2; j++ {
+		for u := range n {
+			link(u, (u+j)%n)
+		}
+	}
+	for j := 1; j <= k/2; j++ {
+		for u := range n {
+			v := (u + j) % n
+			if r.Float64() >= p || len(adj[u]) >= n-1 {
+				continue
+			}
+			if !adjacent(adj, u, v) {
+				continue
+			}
+			w := r.IntN(n)
+			for w == u || adjacent(adj, u, w) {
+				w = r.IntN(n)
+			}
+			delete(adj[u], v)
+			delete(adj[v], u)
+			link(u, w)
+		}
+	}
+	l := &EdgeList{N: n, Edges: make([][2]int, 0, n*k/2)}
+	for u := range n {
+		for v := range adj[u] {
+			if u < v {
+				l.Edges = append(l.Edges, [2]int{u, v})
+			}
+		}
+	}
+	sortEdges(l.Edges)
+	return l
+}
+
+func adjacent(adj []map[int]struct{}, u, v int) bool {
+	_, exists := adj[u][v]
+	return exists
+}
+
+// RandomGeometric returns a random geometric graph: n points placed
+// uniformly in the unit square, joined when they are at most radius apart.
+// Vertices are numbered by their x coordinate, and their coordinates are
+// kept in Positions.
+func RandomGeometric(n int, radius float64, seed uint64) *EdgeList {
+	r := newRand(seed)
+	points := make([][2]float64, n)
+	for i := range points {
+		points[i] = [2]float64{r.Float64(), r.Float64()}
+	}
+	slices.SortFunc(points, func(a, b [2]float64) int {
+		return cmp.Or(cmp.Compare(a[0], b[0]), cmp.Compare(a[1], b[1]))
+	})
+	l := &EdgeList{N: n, Edges: make([][2]int, 0), Positions: points}
+	for u := range points {
+		for v := u + 1; v < n && points[v][0]-points[u][0] <= radius; v++ {
+			if math.Hypot(points[v][0]-points[u][0], points[v][1]-points[u][1]) <= radius {
+				l.Edges = append(l.Edges, [2]int{u, v})
+			}
+		}
+	}
+	return l
+}
+
+// RandomTree returns a uniformly random labeled tree on n vertices, decoded
+// from a random Prüfer sequence in linear time.
+func RandomTree(n int, seed uint64) *EdgeList {
+	l := &EdgeList{N: n, Edges: make([][2]int, 0, max(n-1, 0))}
+	if n < 2 {
+		return l
+	}
+	r := newRand(seed)
+	code := make([]int, n-2)
+	degree := make([]int, n)
+	for i := range code {
+		code[i] = r.IntN(n)
+		degree[code[i]]++
+	}
+	for v := range degree {
+		degree[v]++
+	}
+
+	ptr := 0
+	for degree[ptr] != 1 {
+		ptr++
+	}
+	leaf := ptr
+	for _, v := range code {
+		l.Edges = append(l.Edges, ordered(leaf, v))
+		degree[v]--
+		if degree[v] == 1 && v < ptr {
+			leaf = v
+			continue
+		}
+		for ptr++; degree[ptr] != 1; ptr++ {
+		}
+		leaf = ptr
+	}
+	l.Edges = append(l.Edges, ordered(leaf, n-1))
+	return l
+}
+
+// RandomPlanarTriangulation returns a random maximal planar graph on n >= 3
+// vertices; it has 3n-6 edges and every face is a triangle.
+//
+// The generator grows a stacked triangulation by inserting every vertex
+// into a random face, then performs random edge flips so that the result
+// is not limited to stacked triangulations. The graph is simple; the
+// distribution is not uniform over all triangulations.
+func RandomPlanarTriangulation(n int, seed uint64) *EdgeList {
+	if n < 3 {
+		panic("generators: RandomPlanarTriangulation needs n >= 3")
+	}
+	r := newRand(seed)
+	// The triangle 0-1-2 bounds two faces: the inner and the outer one.
+	faces := [][3]int{{0, 1, 2}, {0, 1, 2}}
+	for v := 3; v < n; v++ {
+		f := r.IntN(len(faces))
+		a, b, c := faces[f][0], faces[f][1], faces[f][2]
+		faces[f] = [3]int{a, b, v}
+		faces = append(faces, [3]int{b, c, v}, [3]int{a, c, v})
+	}
+
+	// edgeFaces maps every edge to the two faces on its sides.
+	edges := make([][2]int, 0, 3*n-6)
+	edgeFaces := make(map[[2]int][]int, 3*n-6)
+	for f, face := range faces {
+		for i := range 3 {
+			e := ordered(face[i], face[(i+1)%3])
+			if edgeFaces[e] == nil {
+				edges = append(edges, e)
+			}
+			edgeFaces[e] = append(edgeFaces[e], f)
+		}
+	}
+	opposite := func(face [3]int, e [2]int) int {
+		for _, v := range face {
+			if v != e[0] && v != e[1] {
+				return v
+			}
+		}
+		panic("generators: degenerate face")
+	}
+	replaceFace := func(e [2]int, from, to int) {
+		fs := edgeFaces[e]
+		fs[slices.Index(fs, from)] = to
+	}
+	for range 3 * n {
+		if n < 4 {
+			break
+		}
+		i := r.IntN(len(edges))
+		e := edges[i]
+		f1, f2 := edgeFaces[e][0], edgeFaces[e][1]
+		c, d := opposite(faces[f1], e), opposite(faces[f2], e)
+		flipped := ordered(c, d)
+		if _, exists := edgeFaces[flipped]; exists {
+			continue
+		}
+		// Faces (a, b, c) and (a, b, d) become (a, c, d) and (b, c, d).
+		a, b := e[0], e[1]
+		faces[f1], faces[f2] = [3]int{a, c, d}, [3]int{b, c, d}
+		replaceFace(ordered(b, c), f1, f2)
+		replaceFace(ordered(a, d), f2, f1)
+		delete(edgeFaces, e)
+		edgeFaces[flipped] = []int{f1, f2}
+		edges[i] = flipped
+	}
+	sortEdges(edges)
+	return &EdgeList{N: n, Edges: edges}
+}
@@ -0,0 +1,159 @@
+package generators
+
+import (
+	"slices"
+	"testing"
+)
+
+// checkSimple checks that l is a simple graph with the given numbers of
+// vertices and edges and, unless degree is negative, is degree-regular.
+func checkSimple(t *testing.T, l *EdgeList, vertices, edges, degree int) {
+	t.Helper()
+	g := l.Multi()
+	if len(l.Edges) != edges || g.VertexCount() != vertices || g.EdgeCount() != edges {
+		t.Fatalf("Expected %d vertices and %d edges, got %d and %d", vertices, edges, g.VertexCount(), g.EdgeCount())
+	}
+	for u, v := range g.Edges() {
+		if u == v || g.Multiplicity(u, v) != 1 {
+			t.Fatalf("Unexpected loop or parallel edge %s-%s", u, v)
+		}
+	}
+	for v := range g.AllVertices() {
+		if d := g.Degree(v); degree >= 0 && d != degree {
+			t.Fatalf("Expected degree %d for %s, got %d", degree, v, d)
+		}
+	}
+}
+
+func connected(l *EdgeList) bool {
+	g := l.Basic()
+	seen := map[string]bool{Name(0): true}
+	stack := []string{Name(0)}
+	for len(stack) > 0 {
+		v := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for u := range g.Neighbors(v) {
+			if !seen[u] {
+				seen[u] = true
+				stack = append(stack, u)
+			}
+		}
+	}
+	return len(seen) == l.N
+}
+
+func TestSeedsAreReproducible(t *testing.T) {
+	generate := []func(seed uint64) *EdgeList{
+		func(seed uint64) *EdgeList { return GNP(40, 0.2, seed) },
+		func(seed uint64) *EdgeList { return GNM(40, 100, seed) },
+		func(seed uint64) *EdgeList { return RandomRegular(20, 3, seed) },
+		func(seed uint64) *EdgeList { return BarabasiAlbert(40, 2, seed) },
+		func(seed uint64) *EdgeList { return WattsStrogatz(40, 4, 0.3, seed) },
+		func(seed uint64) *EdgeList { return RandomGeometric(40, 0.2, seed) },
+		func(seed uint64) *EdgeList { return RandomTree(40, seed) },
+		func(seed uint64) *EdgeList { return RandomPlanarTriangulation(40, seed) },
+	}
+	for i, gen := range generate {
+		a, b, c := gen(1), gen(1), gen(2)
+		if !slices.Equal(a.Edges, b.Edges) {
+			t.Errorf("Generator %d: same seed gave different graphs", i)
+		}
+		if slices.Equal(a.Edges, c.Edges) {
+			t.Errorf("Generator %d: different seeds gave the same graph", i)
+		}
+	}
+}
+
+func TestGNP(t *testing.T) {
+	checkSimple(t, GNP(10, 0, 1), 10, 0, 0)
+	checkSimple(t, GNP(10, 1, 1), 10, 45, 9)
+
+	// The expected number of edges is p*n(n-1)/2 = 4975.
+	if m := len(GNP(200, 0.25, 3).Edges); m < 4600 || m > 5350 {
+		t.Errorf("Unexpected number of edges %d", m)
+	}
+	l := GNP(100, 0.1, 4)
+	checkSimple(t, l, 100, len(l.Edges), -1)
+}
+
+func TestGNM(t *testing.T) {
+	checkSimple(t, GNM(30, 50, 1), 30, 50, -1)
+	checkSimple(t, GNM(30, 400, 1), 30, 400, -1)
+	checkSimple(t, GNM(10, 45, 1), 10, 45, 9)
+}
+
+func TestRandomRegular(t *testing.T) {
+	for seed := range uint64(5) {
+		checkSimple(t, RandomRegular(30, 3, seed), 30, 45, 3)
+		checkSimple(t, RandomRegular(12, 6, seed), 12, 36, 6)
+	}
+	checkSimple(t, RandomRegular(5, 4, 0), 5, 10, 4)
+}
+
+func TestBarabasiAlbert(t *testing.T) {
+	l := BarabasiAlbert(100, 3, 1)
+	checkSimple(t, l, 100, 3+3*96, -1)
+	if !connected(l) {
+		t.Errorf("Expected a connected graph")
+	}
+}
+
+func TestWattsStrogatz(t *testing.T) {
+	checkSimple(t, WattsStrogatz(20, 4, 0, 1), 20, 40, 4)
+	l := WattsStrogatz(100, 6, 0.5, 1)
+	checkSimple(t, l, 100, 300, -1)
+	if slices.Equal(l.Edges, WattsStrogatz(100, 6, 0, 1).Edges) {
+		t.Errorf("Expected rewired edges")
+	}
+}
+
+func TestRandomGeometric(t *testing.T) {
+	l := RandomGeometric(100, 0.15, 1)
+	checkSimple(t, l, 100, len(l.Edges), -1)
+	for i, p := range l.Positions {
+		for j, q := range l.Positions[i+1:] {
+			near := (p[0]-q[0])*(p[0]-q[0])+(p[1]-q[1])*(p[1]-q[1]) <= 0.15*0.15
+			if near != slices.Contains(l.Edges, [2]int{i, i + 1 + j}) {
+				t.Fatalf("Edge %d-%d must exist iff the points are close", i, i+1+j)
+			}
+		}
+	}
+}
+
+func TestRandomTree(t *testing.T) {
+	checkSimple(t, RandomTree(1, 1), 1, 0, 0)
+	for seed := range uint64(10) {
+		l := RandomTree(50, seed)
+		checkSimple(t, l, 50, 49, -1)
+		if !connected(l) {
+			t.Fatalf("Seed %d: expected a tree", seed)
+		}
+	}
+}
+
+func TestRandomPlanarTriangulation(t *testing.T) {
+	checkSimple(t, RandomPlanarTriangulation(3, 1), 3, 3, 2)
+	checkSimple(t, RandomPlanarTriangulation(4, 1), 4, 6, 3)
+	for seed := range uint64(5) {
+		l := RandomPlanarTriangulation(50, seed)
+		checkSimple(t, l, 50, 144, -1)
+		g := l.Basic()
+		for v := range g.AllVertices() {
+			if g.Degree(v) < 3 {
+				t.Fatalf("Seed %d: vertex %s of a triangulation has degree %d", seed, v, g.Degree(v))
+			}
+		}
+	}
+}
+
+func TestWeightedFromGenerator(t *testing.T) {
+	g := GNM(20, 60, 1).Weighted(UniformWeights(1, 10), 5)
+	if g.EdgeCount() != 60 {
+		t.Errorf("Expected 60 edges, got %d", g.EdgeCount())
+	}
+	for e := range g.WeightedEdges() {
+		if e.Weight < 1 || e.Weight > 10 {
+			t.Fatalf("Weight %d outside [1, 10]", e.Weight)
+		}
+	}
+}
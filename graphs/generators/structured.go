@@ -0,0 +1,60 @@
+package generators
+
+// Complete returns the complete graph K_n.
+func Complete(n int) *EdgeList {
+	l := &EdgeList{N: n, Edges: make([][2]int, 0, n*(n-1)/2)}
+	for u := range n {
+		for v := u + 1; v < n; v++ {
+			l.Edges = append(l.Edges, [2]int{u, v})
+		}
+	}
+	return l
+}
+
+// CompleteBipartite returns K_{a,b}: the vertices 0..a-1 form one side and
+// a..a+b-1 the other one.
+func CompleteBipartite(a, b int) *EdgeList {
+	l := &EdgeList{N: a + b, Edges: make([][2]int, 0, a*b)}
+	for u := range a {
+		for v := a; v < a+b; v++ {
+			l.Edges = append(l.Edges, [2]int{u, v})
+		}
+	}
+	return l
+}
+
+// Grid returns the rows × cols grid. Vertex r*cols+c sits at (c, r).
+func Grid(rows, cols int) *EdgeList {
+	return lattice(rows, cols, false)
+}
+
+// Torus returns the rows × cols grid with wrap-around edges, a 4-regular
+// graph. Both dimensions must be at least 3 so that the graph stays simple.
+func Torus(rows, cols int) *EdgeList {
+	if rows < 3 || cols < 3 {
+		panic("generators: Torus needs at least 3 rows and 3 columns")
+	}
+	return lattice(rows, cols, true)
+}
+
+func lattice(rows, cols int, wrap bool) *EdgeList {
+	l := &EdgeList{N: rows * cols, Edges: make([][2]int, 0, 2*rows*cols)}
+	l.Positions = make([][2]float64, 0, l.N)
+	for r := range rows {
+		for c := range cols {
+			v := r*cols + c
+			l.Positions = append(l.Positions, [2]float64{float64(c), float64(r)})
+			if c+1 < cols {
+				l.Edges = append(l.Edges, [2]int{v, v + 1})
+			} else if wrap {
+				l.Edges = append(l.Edges, [2]int{r * cols, v})
+			}
+			if r+1 < rows {
+				l.Edges = append(l.Edges, [2]int{v, v + cols})
+			} else if wrap {
+				l.Edges = append(l.Edges, [2]int{c, v})
+			}
+		}
+	}
+	return l
+}
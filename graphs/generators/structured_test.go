@@ -0,0 +1,29 @@
+package generators
+
+import "testing"
+
+func TestStructured(t *testing.T) {
+	tests := []struct {
+		name            string
+		graph           *EdgeList
+		vertices, edges int
+		degree          int
+	}{
+		{"complete", Complete(6), 6, 15, 5},
+		{"complete bipartite", CompleteBipartite(3, 4), 7, 12, -1},
+		{"grid", Grid(3, 4), 12, 17, -1},
+		{"torus", Torus(3, 4), 12, 24, 4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checkSimple(t, tt.graph, tt.vertices, tt.edges, tt.degree)
+		})
+	}
+}
+
+func TestCompleteBipartiteSides(t *testing.T) {
+	g := CompleteBipartite(2, 3).Basic()
+	if g.HasEdge("0", "1") || g.HasEdge("2", "4") || !g.HasEdge("1", "4") {
+		t.Errorf("Edges must go between the sides")
+	}
+}
@@ -0,0 +1,53 @@
+package generators
+
+import (
+	"math"
+	"math/rand/v2"
+)
+
+// WeightFunc returns the weight of the edge (u, v) using r as the source
+// of randomness.
+type WeightFunc func(r *rand.Rand, u, v int) int
+
+// ConstantWeights gives every edge weight w.
+func ConstantWeights(w int) WeightFunc {
+	return func(*rand.Rand, int, int) int {
+		return w
+	}
+}
+
+// UniformWeights draws weights uniformly from [lo, hi].
+func UniformWeights(lo, hi int) WeightFunc {
+	if lo > hi {
+		panic("generators: UniformWeights needs lo <= hi")
+	}
+	return func(r *rand.Rand, _, _ int) int {
+		return lo + r.IntN(hi-lo+1)
+	}
+}
+
+// NormalWeights draws weights from a normal distribution rounded to the
+// nearest integer and clamped to at least lo.
+func NormalWeights(mean, stddev float64, lo int) WeightFunc {
+	return func(r *rand.Rand, _, _ int) int {
+		return max(int(math.Round(r.NormFloat64()*stddev+mean)), lo)
+	}
+}
+
+// ExponentialWeights draws weights from an exponential distribution with
+// the given mean, rounded up so that every weight is at least 1.
+func ExponentialWeights(mean float64) WeightFunc {
+	return func(r *rand.Rand, _, _ int) int {
+		return max(int(math.Ceil(r.ExpFloat64()*mean)), 1)
+	}
+}
+
+// DistanceWeights weighs an edge by the Euclidean distance between the
+// positions of its endpoints multiplied by scale and rounded up.
+func DistanceWeights(positions [][2]float64, scale float64) WeightFunc {
+	return func(_ *rand.Rand, u, v int) int {
+		dx := positions[u][0] - positions[v][0]
+		dy := positions[u][1] - positions[v][1]
+		return int(math.Ceil(math.Hypot(dx, dy) * scale))
+	}
+}
@@ -0,0 +1,28 @@
+package generators
+
+import "testing"
+
+func TestWeightDistributions(t *testing.T) {
+	r := newRand(1)
+	for range 1000 {
+		if w := UniformWeights(3, 5)(r, 0, 1); w < 3 || w > 5 {
+			t.Fatalf("Uniform weight %d outside [3, 5]", w)
+		}
+		if w := NormalWeights(10, 5, 1)(r, 0, 1); w < 1 {
+			t.Fatalf("Normal weight %d below 1", w)
+		}
+		if w := ExponentialWeights(2)(r, 0, 1); w < 1 {
+			t.Fatalf("Exponential weight %d below 1", w)
+		}
+	}
+}
+
+func TestDistanceWeights(t *testing.T) {
+	l := RandomGeometric(50, 0.3, 2)
+	g := l.Weighted(DistanceWeights(l.Positions, 100), 0)
+	for e := range g.WeightedEdges() {
+		if e.Weight < 0 || e.Weight > 30 {
+			t.Fatalf("Edge %s-%s longer than the radius: %d", e.U, e.V, e.Weight)
+		}
+	}
+}
@@ -1,9 +1,99 @@
 package graphs
 
+import (
+	"cmp"
+	"iter"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Vertex is the set of types usable as vertex IDs in the generic graph types.
+// Vertices must be ordered so that undirected edges have a canonical (u < v) form.
+type Vertex interface {
+	cmp.Ordered
+}
+
+// Weight is the set of types usable as edge weights in the generic graph types.
+type Weight interface {
+	constraints.Integer | constraints.Float
+}
+
+// Graph is a read-only view of a graph with string vertex IDs.
+//
+// For directed graphs Neighbors, IncidentEdges and Degree refer to outgoing
+// edges. Edges yields every edge once, an undirected edge as a (u, v) pair
+// with u <= v. Iteration order is unspecified; wrap an iterator in Sorted or
+// SortedPairs for a deterministic order.
 type Graph interface {
-	AddEdge(vertex1, vertex2 string, weight ...int)
-	RemoveEdge(vertex1, vertex2 string)
-	GetNeighbors(vertex string) []string
+	Directed() bool
+	HasVertex(vertex string) bool
+	VertexCount() int
+	EdgeCount() int
+	SortedVertices() []string
+	AllVertices() iter.Seq[string]
+	Neighbors(vertex string) iter.Seq[string]
+	Edges() iter.Seq2[string, string]
+	IncidentEdges(vertex string) iter.Seq2[string, string]
+	Degree(vertex string) int
 	HasEdge(vertex1, vertex2 string) bool
-	GetEdgeWeight(vertex1, vertex2 string) (int, bool)
 }
+
+// WeightedOf is a Graph whose edges carry a weight of type W.
+type WeightedOf[W Weight] interface {
+	Graph
+	WeightedNeighbors(vertex string) iter.Seq2[string, W]
+	WeightedEdges() iter.Seq[WeightedEdgeOf[string, W]]
+	GetEdgeWeight(vertex1, vertex2 string) (W, bool)
+}
+
+// Weighted is a Graph whose edges carry an integer weight.
+type Weighted = WeightedOf[int]
+
+// Multi is a Graph that may hold several parallel edges between two vertices.
+//
+// Multiplicity counts edges, so a single self-loop has multiplicity 1, like
+// it is counted once by EdgeCount and Edges. Degree counts endpoints
+// instead: a self-loop adds 2 to the degree of its vertex.
+type Multi interface {
+	Graph
+	Multiplicity(vertex1, vertex2 string) int
+}
+
+// Mutable is a Graph with unweighted edge insertion and removal.
+type Mutable interface {
+	Graph
+	AddVertex(vertex string)
+	RemoveVertex(vertex string)
+	AddEdge(vertex1, vertex2 string) EdgeStatus
+	RemoveEdge(vertex1, vertex2 string)
+}
+
+// MutableWeighted is a Weighted graph with edge insertion and removal.
+type MutableWeighted interface {
+	Weighted
+	AddVertex(vertex string)
+	RemoveVertex(vertex string)
+	AddEdge(vertex1, vertex2 string, weight int)
+	RemoveEdge(vertex1, vertex2 string)
+}
+
+var (
+	_ Mutable         = (*BasicGraph)(nil)
+	_ Mutable         = (*DirectedGraph)(nil)
+	_ Mutable         = (*MultiGraph)(nil)
+	_ Multi           = (*MultiGraph)(nil)
+	_ Multi           = (*BasicGraph)(nil)
+	_ Multi           = (*DirectedGraph)(nil)
+	_ MutableWeighted = (*WeightedGraph)(nil)
+	_ MutableWeighted = (*WeightedOrientedGraph)(nil)
+	_ Weighted        = (*CSRGraph)(nil)
+
+	_ WeightedOf[float64] = (*WeightedGraphOf[string, float64])(nil)
+	_ WeightedOf[float64] = (*WeightedOrientedGraphOf[string, float64])(nil)
+	_ WeightedOf[float64] = (*CSRGraphOf[float64])(nil)
+	_ Attributed          = (*BasicGraph)(nil)
+	_ Attributed          = (*DirectedGraph)(nil)
+	_ Attributed          = (*MultiGraph)(nil)
+	_ Attributed          = (*WeightedGraph)(nil)
+	_ Attributed          = (*WeightedOrientedGraph)(nil)
+)
@@ -0,0 +1,83 @@
+package graphs
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestGraphInterfaceAcrossTypes(t *testing.T) {
+	basic := NewBasicGraph()
+	basic.AddEdge("A", "B")
+	basic.AddEdge("A", "C")
+
+	directed := NewDirectedGraph()
+	directed.AddEdge("A", "B")
+	directed.AddEdge("A", "C")
+
+	weighted := NewWeightedGraph()
+	weighted.AddEdge("A", "B", 1)
+	weighted.AddEdge("A", "C", 2)
+
+	oriented := NewWeightedOrientedGraph()
+	oriented.AddEdge("A", "B", 1)
+	oriented.AddEdge("A", "C", 2)
+
+	multi := NewMultiGraph()
+	multi.AddEdge("A", "B")
+	multi.AddEdge("A", "C")
+
+	tests := []struct {
+		name     string
+		graph    Graph
+		directed bool
+	}{
+		{"basic", basic, false},
+		{"directed", directed, true},
+		{"weighted", weighted, false},
+		{"weighted_oriented", oriented, true},
+		{"multi", multi, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := tt.graph
+			if g.Directed() != tt.directed {
+				t.Errorf("Expected Directed() = %v", tt.directed)
+			}
+			neighbors := slices.Sorted(g.Neighbors("A"))
+			if !slices.Equal(neighbors, []string{"B", "C"}) {
+				t.Errorf("Expected neighbors [B C] of A, got %v", neighbors)
+			}
+			if d := g.Degree("A"); d != 2 {
+				t.Errorf("Expected degree 2 for A, got %d", d)
+			}
+			if !g.HasEdge("A", "B") {
+				t.Errorf("Expected edge between A and B to exist")
+			}
+			if g.HasEdge("B", "A") == tt.directed {
+				t.Errorf("Unexpected HasEdge(B, A) for directed=%v", tt.directed)
+			}
+		})
+	}
+}
+
+func TestMultiplicityInMultiGraph(t *testing.T) {
+	graph := NewMultiGraph()
+	graph.AddEdge("A", "B")
+	graph.AddEdge("A", "B")
+
+	var g Multi = graph
+	if m := g.Multiplicity("A", "B"); m != 2 {
+		t.Errorf("Expected multiplicity 2, got %d", m)
+	}
+	if d := g.Degree("A"); d != 2 {
+		t.Errorf("Expected degree 2 for A, got %d", d)
+	}
+	if n := len(slices.Collect(g.Neighbors("A"))); n != 1 {
+		t.Errorf("Expected a single distinct neighbor, got %d", n)
+	}
+	graph.AddEdge("C", "C")
+	if m := g.Multiplicity("C", "C"); m != 1 || g.EdgeCount() != 3 || g.Degree("C") != 2 {
+		t.Errorf("Expected a single self-loop of degree 2, got multiplicity %d", m)
+	}
+}
@@ -0,0 +1,57 @@
+package graphs
+
+import (
+	"cmp"
+	"iter"
+	"slices"
+)
+
+// Sorted yields the elements of seq in ascending order.
+//
+// The sequence is collected when iteration starts, so sorted iteration
+// costs a slice of all elements.
+func Sorted[N Vertex](seq iter.Seq[N]) iter.Seq[N] {
+	return func(yield func(N) bool) {
+		for _, v := range slices.Sorted(seq) {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// SortedPairs yields the pairs of seq ordered by the first element and then
+// by the second one. It is meant for Edges and IncidentEdges.
+func SortedPairs[N Vertex](seq iter.Seq2[N, N]) iter.Seq2[N, N] {
+	return func(yield func(N, N) bool) {
+		pairs := make([][2]N, 0)
+		for u, v := range seq {
+			pairs = append(pairs, [2]N{u, v})
+		}
+		slices.SortFunc(pairs, func(a, b [2]N) int {
+			if c := cmp.Compare(a[0], b[0]); c != 0 {
+				return c
+			}
+			return cmp.Compare(a[1], b[1])
+		})
+		for _, p := range pairs {
+			if !yield(p[0], p[1]) {
+				return
+			}
+		}
+	}
+}
+
+// yieldParallel yields (u, v) once per parallel edge of an undirected graph
+// whose adjacency counts a self-loop at both of its ends.
+func yieldParallel(yield func(string, string) bool, u, v string, count int) bool {
+	if u == v {
+		count /= 2
+	}
+	for range count {
+		if !yield(u, v) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,104 @@
+package graphs
+
+import (
+	"slices"
+	"testing"
+)
+
+type pair = [2]string
+
+func collectPairs(seq func(func(string, string) bool)) []pair {
+	var res []pair
+	for u, v := range SortedPairs(seq) {
+		res = append(res, pair{u, v})
+	}
+	return res
+}
+
+func TestEdgesAcrossTypes(t *testing.T) {
+	basic := NewBasicGraph()
+	basic.AddEdge("B", "A")
+	basic.AddEdge("A", "B")
+	basic.AddEdge("C", "C")
+
+	multi := NewMultiGraph()
+	multi.AddEdge("B", "A")
+	multi.AddEdge("A", "B")
+	multi.AddEdge("C", "C")
+
+	directed := NewDirectedGraph()
+	directed.AddEdge("B", "A")
+	directed.AddEdge("A", "B")
+	directed.AddEdge("C", "C")
+
+	weighted := NewWeightedGraph()
+	weighted.AddEdge("B", "A", 1)
+	weighted.AddEdge("C", "C", 2)
+
+	oriented := NewWeightedOrientedGraph()
+	oriented.AddEdge("B", "A", 1)
+	oriented.AddEdge("A", "B", 2)
+
+	tests := []struct {
+		name     string
+		graph    Graph
+		edges    []pair
+		incident []pair
+	}{
+		{"basic", basic, []pair{{"A", "B"}, {"A", "B"}, {"C", "C"}}, []pair{{"A", "B"}, {"A", "B"}}},
+		{"multi", multi, []pair{{"A", "B"}, {"A", "B"}, {"C", "C"}}, []pair{{"A", "B"}, {"A", "B"}}},
+		{"directed", directed, []pair{{"A", "B"}, {"B", "A"}, {"C", "C"}}, []pair{{"A", "B"}}},
+		{"weighted", weighted, []pair{{"A", "B"}, {"C", "C"}}, []pair{{"A", "B"}}},
+		{"weighted_oriented", oriented, []pair{{"A", "B"}, {"B", "A"}}, []pair{{"A", "B"}}},
+		{"csr", ToCSR(weighted), []pair{{"A", "B"}, {"C", "C"}}, []pair{{"A", "B"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := collectPairs(tt.graph.Edges()); !slices.Equal(got, tt.edges) {
+				t.Errorf("Expected edges %v, got %v", tt.edges, got)
+			}
+			if got := collectPairs(tt.graph.IncidentEdges("A")); !slices.Equal(got, tt.incident) {
+				t.Errorf("Expected edges at A %v, got %v", tt.incident, got)
+			}
+			if got := slices.Collect(Sorted(tt.graph.AllVertices())); !slices.Equal(got, tt.graph.SortedVertices()) {
+				t.Errorf("Expected sorted vertices %v, got %v", tt.graph.SortedVertices(), got)
+			}
+		})
+	}
+}
+
+func TestEdgesStopsEarly(t *testing.T) {
+	graph := NewBasicGraph()
+	for _, v := range []string{"B", "C", "D", "E"} {
+		graph.AddEdge("A", v)
+	}
+
+	count := 0
+	for range graph.Edges() {
+		count++
+		if count == 2 {
+			break
+		}
+	}
+	if count != 2 {
+		t.Errorf("Expected iteration to stop after 2 edges, got %d", count)
+	}
+}
+
+func TestWeightedEdgesInWeightedGraph(t *testing.T) {
+	graph := NewWeightedGraphOf[int, float64]()
+	graph.AddEdge(2, 1, 0.5)
+	graph.AddEdge(2, 3, 1.5)
+
+	sum := 0.0
+	for e := range graph.WeightedEdges() {
+		if e.U > e.V {
+			t.Errorf("Expected U <= V, got %v", e)
+		}
+		sum += e.Weight
+	}
+	if sum != 2 {
+		t.Errorf("Expected total weight 2, got %v", sum)
+	}
+}
@@ -0,0 +1,215 @@
+package graphs
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// graphJSON is the serialized form of a graph:
+//
+//	{"type": "weighted",
+//	 "vertices": [{"id": "A", "attrs": {"x": 1.5}}, {"id": "B"}],
+//	 "edges": [{"u": "A", "v": "B", "weight": 3, "attrs": {"label": "road"}}]}
+//
+// Undirected edges are written once with u <= v; parallel edges are written
+// as one entry with a count. BasicGraph and DirectedGraph with a non-zero
+// EdgePolicy also get a "policy" entry such as
+// {"duplicates": "ignore", "self_loops": "ignore"}.
+type graphJSON struct {
+	Type     string       `json:"type"`
+	Policy   *policyJSON  `json:"policy,omitempty"`
+	Vertices []vertexJSON `json:"vertices"`
+	Edges    []edgeJSON   `json:"edges"`
+}
+
+type policyJSON struct {
+	Duplicates string `json:"duplicates"`
+	SelfLoops  string `json:"self_loops"`
+}
+
+type vertexJSON struct {
+	ID    string         `json:"id"`
+	Attrs map[string]any `json:"attrs,omitempty"`
+}
+
+type edgeJSON struct {
+	U      string         `json:"u"`
+	V      string         `json:"v"`
+	Weight int            `json:"weight,omitempty"`
+	Count  int            `json:"count,omitempty"`
+	Attrs  map[string]any `json:"attrs,omitempty"`
+}
+
+const (
+	basicType            = "basic"
+	directedType         = "directed"
+	multiType            = "multi"
+	weightedType         = "weighted"
+	weightedOrientedType = "weighted_oriented"
+)
+
+func graphType(g Graph) string {
+	switch g.(type) {
+	case *BasicGraph:
+		return basicType
+	case *DirectedGraph:
+		return directedType
+	case *MultiGraph:
+		return multiType
+	}
+	_, weighted := g.(Weighted)
+	switch {
+	case weighted && g.Directed():
+		return weightedOrientedType
+	case weighted:
+		return weightedType
+	case g.Directed():
+		return directedType
+	default:
+		return basicType
+	}
+}
+
+var edgeActionNames = map[EdgeAction]string{
+	AllowEdge:  "allow",
+	IgnoreEdge: "ignore",
+	RejectEdge: "reject",
+}
+
+func encodePolicy(g Graph) *policyJSON {
+	p, ok := g.(interface{ Policy() EdgePolicy })
+	if !ok || p.Policy() == (EdgePolicy{}) {
+		return nil
+	}
+	return &policyJSON{
+		Duplicates: edgeActionNames[p.Policy().Duplicates],
+		SelfLoops:  edgeActionNames[p.Policy().SelfLoops],
+	}
+}
+
+func decodePolicy(src *policyJSON) (EdgePolicy, error) {
+	if src == nil {
+		return EdgePolicy{}, nil
+	}
+	duplicates, err := parseEdgeAction(src.Duplicates)
+	if err != nil {
+		return EdgePolicy{}, err
+	}
+	selfLoops, err := parseEdgeAction(src.SelfLoops)
+	if err != nil {
+		return EdgePolicy{}, err
+	}
+	return EdgePolicy{Duplicates: duplicates, SelfLoops: selfLoops}, nil
+}
+
+func parseEdgeAction(name string) (EdgeAction, error) {
+	for action, actionName := range edgeActionNames {
+		if actionName == name {
+			return action, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown edge action %q", name)
+}
+
+// MarshalGraph encodes g, including its attributes and edge policy, as JSON.
+//
+// Attribute values are encoded with encoding/json. UnmarshalGraph restores
+// them lazily: a value is decoded into the type of the key it is read with.
+func MarshalGraph(g Graph) ([]byte, error) {
+	attrs := readAttrs(g)
+	if attrs == nil {
+		attrs = newAttributes[string](g.Directed())
+	}
+
+	res := graphJSON{Type: graphType(g), Policy: encodePolicy(g), Vertices: []vertexJSON{}, Edges: []edgeJSON{}}
+	for _, v := range g.SortedVertices() {
+		res.Vertices = append(res.Vertices, vertexJSON{v, attrs.vertex[v]})
+	}
+	for _, e := range (ConvertOptions{}).edges(g, g.Directed()) {
+		if last := len(res.Edges) - 1; last >= 0 && res.Edges[last].U == e.U && res.Edges[last].V == e.V {
+			res.Edges[last].Count = max(res.Edges[last].Count, 1) + 1
+			continue
+		}
+		entry := edgeJSON{U: e.U, V: e.V, Attrs: attrs.edge[e.U][e.V]}
+		if _, weighted := g.(Weighted); weighted {
+			entry.Weight = e.Weight
+		}
+		res.Edges = append(res.Edges, entry)
+	}
+	return json.Marshal(res)
+}
+
+// UnmarshalGraph decodes a graph written by MarshalGraph. The result has the
+// type recorded in the data: *BasicGraph, *DirectedGraph, *MultiGraph,
+// *WeightedGraph or *WeightedOrientedGraph. A recorded policy is restored for
+// *BasicGraph and *DirectedGraph.
+func UnmarshalGraph(data []byte) (AttributedGraph, error) {
+	var src struct {
+		Type     string      `json:"type"`
+		Policy   *policyJSON `json:"policy"`
+		Vertices []struct {
+			ID    string                     `json:"id"`
+			Attrs map[string]json.RawMessage `json:"attrs"`
+		} `json:"vertices"`
+		Edges []struct {
+			U      string                     `json:"u"`
+			V      string                     `json:"v"`
+			Weight int                        `json:"weight"`
+			Count  int                        `json:"count"`
+			Attrs  map[string]json.RawMessage `json:"attrs"`
+		} `json:"edges"`
+	}
+	if err := json.Unmarshal(data, &src); err != nil {
+		return nil, err
+	}
+	policy, err := decodePolicy(src.Policy)
+	if err != nil {
+		return nil, err
+	}
+	if src.Policy != nil && src.Type != basicType && src.Type != directedType {
+		return nil, fmt.Errorf("graph type %q has no edge policy", src.Type)
+	}
+
+	var g AttributedGraph
+	var addVertex func(v string)
+	var addEdge func(u, v string, weight int)
+	switch src.Type {
+	case basicType:
+		b := NewBasicGraphWithPolicy(policy)
+		g, addVertex, addEdge = b, b.AddVertex, func(u, v string, _ int) { b.AddEdge(u, v) }
+	case directedType:
+		d := NewDirectedGraphWithPolicy(policy)
+		g, addVertex, addEdge = d, d.AddVertex, func(u, v string, _ int) { d.AddEdge(u, v) }
+	case multiType:
+		m := NewMultiGraph()
+		g, addVertex, addEdge = m, m.AddVertex, func(u, v string, _ int) { m.AddEdge(u, v) }
+	case weightedType:
+		w := NewWeightedGraph()
+		g, addVertex, addEdge = w, w.AddVertex, w.AddEdge
+	case weightedOrientedType:
+		w := NewWeightedOrientedGraph()
+		g, addVertex, addEdge = w, w.AddVertex, w.AddEdge
+	default:
+		return nil, fmt.Errorf("unknown graph type %q", src.Type)
+	}
+
+	attrs := g.Attrs()
+	for _, v := range src.Vertices {
+		addVertex(v.ID)
+		for name, raw := range v.Attrs {
+			attrs.vertexProps(v.ID, true)[name] = raw
+		}
+	}
+	for _, e := range src.Edges {
+		if e.Count < 0 {
+			return nil, fmt.Errorf("invalid count %d of edge %s-%s", e.Count, e.U, e.V)
+		}
+		for range max(e.Count, 1) {
+			addEdge(e.U, e.V, e.Weight)
+		}
+		for name, raw := range e.Attrs {
+			attrs.edgeProps(e.U, e.V, true)[name] = raw
+		}
+	}
+	return g, nil
+}
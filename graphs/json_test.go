@@ -0,0 +1,159 @@
+package graphs
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestGraphJSONRoundTrip(t *testing.T) {
+	weighted := NewWeightedGraph()
+	weighted.AddEdge("B", "A", 3)
+	weighted.AddEdge("B", "C", 0)
+	weighted.AddVertex("D")
+	SetVertexAttr(weighted.Attrs(), "A", pointKey, [2]float64{0.5, -1})
+	SetEdgeAttr(weighted.Attrs(), "A", "B", labelKey, "road")
+
+	data, err := MarshalGraph(weighted)
+	if err != nil {
+		t.Fatalf("MarshalGraph failed: %v", err)
+	}
+	g, err := UnmarshalGraph(data)
+	if err != nil {
+		t.Fatalf("UnmarshalGraph failed: %v", err)
+	}
+
+	decoded, ok := g.(*WeightedGraph)
+	if !ok {
+		t.Fatalf("Expected *WeightedGraph, got %T", g)
+	}
+	if !decoded.Equal(weighted) {
+		t.Errorf("Expected equal graphs, diff %+v", weighted.Diff(decoded))
+	}
+	if p, _ := VertexAttr(decoded.Attrs(), "A", pointKey); p != [2]float64{0.5, -1} {
+		t.Errorf("Expected point (0.5, -1), got %v", p)
+	}
+	if l, _ := EdgeAttr(decoded.Attrs(), "B", "A", labelKey); l != "road" {
+		t.Errorf("Expected label road, got %q", l)
+	}
+
+	again, err := MarshalGraph(decoded)
+	if err != nil || string(again) != string(data) {
+		t.Errorf("Expected stable encoding, got %s and %s (%v)", data, again, err)
+	}
+}
+
+func TestDecodedAttributesAreReadOnly(t *testing.T) {
+	graph := NewBasicGraph()
+	graph.AddEdge("A", "B")
+	SetVertexAttr(graph.Attrs(), "A", pointKey, [2]float64{1, 2})
+	data, err := MarshalGraph(graph)
+	if err != nil {
+		t.Fatalf("MarshalGraph failed: %v", err)
+	}
+	g, err := UnmarshalGraph(data)
+	if err != nil {
+		t.Fatalf("UnmarshalGraph failed: %v", err)
+	}
+
+	// Reads decode the value every time and must not race (go test -race).
+	var wg sync.WaitGroup
+	for range 4 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if p, _ := VertexAttr(g.Attrs(), "A", pointKey); p != [2]float64{1, 2} {
+				t.Errorf("Expected point (1, 2), got %v", p)
+			}
+		}()
+	}
+	wg.Wait()
+
+	// Neither conversion nor encoding creates attributes on the source.
+	plain := NewBasicGraph()
+	plain.AddEdge("A", "B")
+	ToDirectedGraph(plain, ConvertOptions{})
+	if _, err := MarshalGraph(plain); err != nil {
+		t.Fatalf("MarshalGraph failed: %v", err)
+	}
+	if plain.storedAttrs() != nil {
+		t.Errorf("Expected no attributes on the source graph")
+	}
+}
+
+func TestGraphJSONParallelEdges(t *testing.T) {
+	multi := NewMultiGraph()
+	multi.AddEdge("A", "B")
+	multi.AddEdge("B", "A")
+	multi.AddEdge("C", "C")
+
+	directed := NewDirectedGraph()
+	directed.AddEdge("A", "B")
+	directed.AddEdge("A", "B")
+	directed.AddEdge("B", "A")
+
+	for _, g := range []AttributedGraph{multi, directed} {
+		data, err := MarshalGraph(g)
+		if err != nil {
+			t.Fatalf("MarshalGraph failed: %v", err)
+		}
+		decoded, err := UnmarshalGraph(data)
+		if err != nil {
+			t.Fatalf("UnmarshalGraph failed: %v", err)
+		}
+		if graphType(decoded) != graphType(g) {
+			t.Errorf("Expected type %s, got %s", graphType(g), graphType(decoded))
+		}
+		if m1, m2 := multiplicities(g), multiplicities(decoded); len(m1) != len(m2) {
+			t.Errorf("Expected edges %v, got %v", m1, m2)
+		} else {
+			for k, c := range m1 {
+				if m2[k] != c {
+					t.Errorf("Expected %d copies of %v, got %d", c, k, m2[k])
+				}
+			}
+		}
+	}
+}
+
+func TestUnmarshalGraphErrors(t *testing.T) {
+	for _, data := range []string{
+		`{"type": "hypergraph"}`,
+		`{"type": "basic", "edges": [{"u": "A", "v": "B", "count": -1}]}`,
+		`not json`,
+	} {
+		if _, err := UnmarshalGraph([]byte(data)); err == nil {
+			t.Errorf("Expected an error for %s", data)
+		}
+	}
+}
+
+func TestGraphJSONKeepsPolicy(t *testing.T) {
+	graph := NewDirectedGraphWithPolicy(SimplePolicy)
+	graph.AddEdge("A", "B")
+	data, err := MarshalGraph(graph)
+	if err != nil {
+		t.Fatalf("MarshalGraph failed: %v", err)
+	}
+	g, err := UnmarshalGraph(data)
+	if err != nil {
+		t.Fatalf("UnmarshalGraph failed: %v", err)
+	}
+
+	decoded, ok := g.(*DirectedGraph)
+	if !ok {
+		t.Fatalf("Expected *DirectedGraph, got %T", g)
+	}
+	if decoded.Policy() != SimplePolicy {
+		t.Errorf("Expected SimplePolicy, got %+v", decoded.Policy())
+	}
+	if status := decoded.AddEdge("A", "B"); status != DuplicateIgnored {
+		t.Errorf("Expected the duplicate to be ignored, got %v", status)
+	}
+
+	if _, err := UnmarshalGraph([]byte(`{"type": "basic", "policy": {"duplicates": "drop", "self_loops": "allow"}}`)); err == nil {
+		t.Errorf("Expected an error for an unknown edge action")
+	}
+	if _, err := UnmarshalGraph([]byte(`{"type": "multi", "policy": {"duplicates": "allow", "self_loops": "allow"}}`)); err == nil {
+		t.Errorf("Expected an error for a policy on a multigraph")
+	}
+}
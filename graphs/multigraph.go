@@ -1,9 +1,15 @@
 package graphs
 
-import "fmt"
+import (
+	"fmt"
+	"iter"
+	"maps"
+	"slices"
+)
 
 type MultiGraph struct {
 	Vertices map[string]map[string]int
+	attrs    *Attributes
 }
 
 func NewMultiGraph() *MultiGraph {
@@ -12,7 +18,8 @@ func NewMultiGraph() *MultiGraph {
 	}
 }
 
-func (g *MultiGraph) AddEdge(vertex1, vertex2 string) {
+// AddEdge adds one more parallel edge; a multigraph accepts every edge.
+func (g *MultiGraph) AddEdge(vertex1, vertex2 string) EdgeStatus {
 	if g.Vertices[vertex1] == nil {
 		g.Vertices[vertex1] = make(map[string]int)
 	}
@@ -22,6 +29,7 @@ func (g *MultiGraph) AddEdge(vertex1, vertex2 string) {
 
 	g.Vertices[vertex1][vertex2]++
 	g.Vertices[vertex2][vertex1]++
+	return EdgeAdded
 }
 
 func (g *MultiGraph) RemoveEdge(vertex1, vertex2 string) {
@@ -29,6 +37,18 @@ func (g *MultiGraph) RemoveEdge(vertex1, vertex2 string) {
 		g.Vertices[vertex1][vertex2]--
 		g.Vertices[vertex2][vertex1]--
 	}
+	if g.attrs != nil && !g.HasEdge(vertex1, vertex2) {
+		g.attrs.removeEdge(vertex1, vertex2)
+	}
+}
+
+// Attrs returns the vertex and edge attributes of the graph.
+func (g *MultiGraph) Attrs() *Attributes {
+	return lazyAttrs(&g.attrs, false)
+}
+
+func (g *MultiGraph) storedAttrs() *Attributes {
+	return g.attrs
 }
 
 func (g *MultiGraph) GetNeighbors(vertex string) []string {
@@ -43,6 +63,109 @@ func (g *MultiGraph) HasEdge(vertex1, vertex2 string) bool {
 	return g.Vertices[vertex1][vertex2] > 0
 }
 
+func (g *MultiGraph) Directed() bool {
+	return false
+}
+
+func (g *MultiGraph) AllVertices() iter.Seq[string] {
+	return maps.Keys(g.Vertices)
+}
+
+// Neighbors yields every adjacent vertex once, regardless of multiplicity.
+func (g *MultiGraph) Neighbors(vertex string) iter.Seq[string] {
+	return func(yield func(string) bool) {
+		for neighbor, count := range g.Vertices[vertex] {
+			if count > 0 && !yield(neighbor) {
+				return
+			}
+		}
+	}
+}
+
+// Degree counts parallel edges with their multiplicity.
+func (g *MultiGraph) Degree(vertex string) int {
+	sum := 0
+	for _, count := range g.Vertices[vertex] {
+		sum += count
+	}
+	return sum
+}
+
+// Multiplicity returns the number of parallel edges between the vertices.
+func (g *MultiGraph) Multiplicity(vertex1, vertex2 string) int {
+	// Both endpoints of a self-loop are counted in the same entry.
+	if vertex1 == vertex2 {
+		return g.Vertices[vertex1][vertex2] / 2
+	}
+	return g.Vertices[vertex1][vertex2]
+}
+
+// AddVertex adds an isolated vertex; an existing vertex is left unchanged.
+func (g *MultiGraph) AddVertex(vertex string) {
+	if g.Vertices[vertex] == nil {
+		g.Vertices[vertex] = make(map[string]int)
+	}
+}
+
+// RemoveVertex removes vertex together with all incident edges.
+func (g *MultiGraph) RemoveVertex(vertex string) {
+	for neighbor := range g.Vertices[vertex] {
+		delete(g.Vertices[neighbor], vertex)
+	}
+	delete(g.Vertices, vertex)
+	if g.attrs != nil {
+		g.attrs.removeVertex(vertex)
+	}
+}
+
+func (g *MultiGraph) HasVertex(vertex string) bool {
+	_, exists := g.Vertices[vertex]
+	return exists
+}
+
+func (g *MultiGraph) VertexCount() int {
+	return len(g.Vertices)
+}
+
+// EdgeCount counts parallel edges with their multiplicity.
+func (g *MultiGraph) EdgeCount() int {
+	endpoints := 0
+	for vertex := range g.Vertices {
+		endpoints += g.Degree(vertex)
+	}
+	return endpoints / 2
+}
+
+// SortedVertices returns all vertices in ascending order.
+func (g *MultiGraph) SortedVertices() []string {
+	return slices.Sorted(maps.Keys(g.Vertices))
+}
+
+// Edges yields every edge once as a (u, v) pair with u <= v.
+// Parallel edges are yielded once each.
+func (g *MultiGraph) Edges() iter.Seq2[string, string] {
+	return func(yield func(string, string) bool) {
+		for u, neighbors := range g.Vertices {
+			for v, count := range neighbors {
+				if u <= v && !yieldParallel(yield, u, v, count) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// IncidentEdges yields (vertex, u) once for every edge between vertex and u.
+func (g *MultiGraph) IncidentEdges(vertex string) iter.Seq2[string, string] {
+	return func(yield func(string, string) bool) {
+		for v, count := range g.Vertices[vertex] {
+			if !yieldParallel(yield, vertex, v, count) {
+				return
+			}
+		}
+	}
+}
+
 func (g *MultiGraph) String() string {
 	result := ""
 	for vertex1, neighbors := range g.Vertices {
@@ -1,6 +1,7 @@
 package graphs
 
 import (
+	"slices"
 	"testing"
 )
 
@@ -91,3 +92,26 @@ func TestMultipleEdgesBetweenVertices(t *testing.T) {
 func countEdges(graph *MultiGraph, vertex1, vertex2 string) int {
 	return graph.Vertices[vertex1][vertex2]
 }
+
+func TestVertexOperationsInMultiGraph(t *testing.T) {
+	graph := NewMultiGraph()
+	graph.AddVertex("D")
+	graph.AddEdge("A", "B")
+	graph.AddEdge("A", "B")
+	graph.AddEdge("B", "C")
+
+	if graph.VertexCount() != 4 || graph.EdgeCount() != 3 {
+		t.Errorf("Expected 4 vertices and 3 edges, got %d and %d", graph.VertexCount(), graph.EdgeCount())
+	}
+
+	graph.RemoveVertex("A")
+	if graph.HasEdge("B", "A") {
+		t.Errorf("Expected edges incident to A to be removed")
+	}
+	if graph.EdgeCount() != 1 {
+		t.Errorf("Expected 1 edge, got %d", graph.EdgeCount())
+	}
+	if !slices.Equal(graph.SortedVertices(), []string{"B", "C", "D"}) {
+		t.Errorf("Expected vertices [B C D], got %v", graph.SortedVertices())
+	}
+}
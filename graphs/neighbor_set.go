@@ -0,0 +1,131 @@
+package graphs
+
+import "iter"
+
+// neighborSet is an insertion-ordered multiset of neighbors with constant
+// time membership tests, insertion and removal.
+//
+// A neighbor occupies one slot of order no matter how many parallel edges
+// lead to it. Removing the last edge leaves a dead slot behind; dead slots
+// are skipped during iteration and compacted away once they dominate.
+type neighborSet struct {
+	entries map[string]neighborEntry
+	order   []string
+	dead    int
+	edges   int
+}
+
+type neighborEntry struct {
+	slot  int
+	count int
+}
+
+func newNeighborSet() *neighborSet {
+	return &neighborSet{entries: make(map[string]neighborEntry)}
+}
+
+func (s *neighborSet) add(v string) {
+	e, exists := s.entries[v]
+	if !exists {
+		e.slot = len(s.order)
+		s.order = append(s.order, v)
+	}
+	e.count++
+	s.entries[v] = e
+	s.edges++
+}
+
+// remove deletes one edge to v and reports whether there was one.
+func (s *neighborSet) remove(v string) bool {
+	e, exists := s.entries[v]
+	if !exists {
+		return false
+	}
+	if e.count--; e.count > 0 {
+		s.entries[v] = e
+		s.edges--
+		return true
+	}
+	s.removeAll(v)
+	return true
+}
+
+// removeAll deletes every edge to v.
+func (s *neighborSet) removeAll(v string) {
+	e, exists := s.entries[v]
+	if !exists {
+		return
+	}
+	delete(s.entries, v)
+	s.edges -= e.count
+	s.dead++
+	if s.dead > len(s.order)/2 {
+		s.compact()
+	}
+}
+
+func (s *neighborSet) compact() {
+	live := make([]string, 0, len(s.entries))
+	for v := range s.all() {
+		e := s.entries[v]
+		e.slot = len(live)
+		s.entries[v] = e
+		live = append(live, v)
+	}
+	s.order = live
+	s.dead = 0
+}
+
+func (s *neighborSet) has(v string) bool {
+	_, exists := s.entries[v]
+	return exists
+}
+
+func (s *neighborSet) count(v string) int {
+	return s.entries[v].count
+}
+
+// total returns the number of edges, counting parallel edges separately.
+func (s *neighborSet) total() int {
+	return s.edges
+}
+
+// all yields every distinct neighbor in insertion order.
+func (s *neighborSet) all() iter.Seq[string] {
+	return func(yield func(string) bool) {
+		for i, v := range s.order {
+			if e, exists := s.entries[v]; !exists || e.slot != i {
+				continue
+			}
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// list returns a fresh slice of neighbors in insertion order, repeating
+// a neighbor once per parallel edge.
+func (s *neighborSet) list() []string {
+	res := make([]string, 0, len(s.entries))
+	for v := range s.all() {
+		for range s.entries[v].count {
+			res = append(res, v)
+		}
+	}
+	return res
+}
+
+// clone returns an independent copy with dead slots compacted away.
+func (s *neighborSet) clone() *neighborSet {
+	c := &neighborSet{
+		entries: make(map[string]neighborEntry, len(s.entries)),
+		order:   make([]string, 0, len(s.entries)),
+		edges:   s.edges,
+	}
+	for v := range s.all() {
+		c.entries[v] = neighborEntry{slot: len(c.order), count: s.entries[v].count}
+		c.order = append(c.order, v)
+	}
+	return c
+}
@@ -0,0 +1,56 @@
+package graphs
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestNeighborSetKeepsInsertionOrder(t *testing.T) {
+	s := newNeighborSet()
+	for _, v := range []string{"C", "A", "B", "A"} {
+		s.add(v)
+	}
+	if got := slices.Collect(s.all()); !slices.Equal(got, []string{"C", "A", "B"}) {
+		t.Errorf("Expected [C A B], got %v", got)
+	}
+	if got := s.list(); !slices.Equal(got, []string{"C", "A", "A", "B"}) {
+		t.Errorf("Expected [C A A B], got %v", got)
+	}
+	if s.total() != 4 || s.count("A") != 2 {
+		t.Errorf("Expected 4 edges with 2 to A, got %d and %d", s.total(), s.count("A"))
+	}
+
+	s.remove("A")
+	if !s.has("A") || s.count("A") != 1 {
+		t.Errorf("Expected one edge to A to remain")
+	}
+	s.remove("A")
+	s.add("A")
+	if got := slices.Collect(s.all()); !slices.Equal(got, []string{"C", "B", "A"}) {
+		t.Errorf("Expected re-added A at the end, got %v", got)
+	}
+}
+
+func TestNeighborSetCompaction(t *testing.T) {
+	s := newNeighborSet()
+	for i := range 100 {
+		s.add(string(rune('a'+i%26)) + string(rune('a'+i/26)))
+	}
+	kept := []string{}
+	for i, v := range slices.Collect(s.all()) {
+		if i%10 == 0 {
+			kept = append(kept, v)
+		} else {
+			s.removeAll(v)
+		}
+	}
+	if len(s.order) >= 100 {
+		t.Errorf("Expected dead slots to be compacted, order has %d slots", len(s.order))
+	}
+	if got := slices.Collect(s.all()); !slices.Equal(got, kept) {
+		t.Errorf("Expected %v, got %v", kept, got)
+	}
+	if s.total() != len(kept) {
+		t.Errorf("Expected %d edges, got %d", len(kept), s.total())
+	}
+}
@@ -0,0 +1,199 @@
+package graphs
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// The operators below treat their inputs as simple undirected graphs: arc
+// directions are ignored, parallel edges count once and self-loops are
+// dropped. They return new graphs with SimplePolicy.
+
+// ProductVertex names the vertex (u, v) of a product graph.
+//
+// Backslashes, commas and parentheses inside u and v are escaped with a
+// backslash, so different pairs always get different names: ("a,b", "c")
+// becomes (a\,b,c) and ("a", "b,c") becomes (a,b\,c).
+func ProductVertex(u, v string) string {
+	return "(" + productEscaper.Replace(u) + "," + productEscaper.Replace(v) + ")"
+}
+
+var productEscaper = strings.NewReplacer(`\`, `\\`, ",", `\,`, "(", `\(`, ")", `\)`)
+
+// simpleNeighbors returns the sorted neighbors of every vertex of g in the
+// underlying simple undirected graph.
+func simpleNeighbors(g Graph) map[string][]string {
+	sets := make(map[string]map[string]struct{})
+	for v := range g.AllVertices() {
+		sets[v] = make(map[string]struct{})
+	}
+	for u, v := range g.Edges() {
+		if u != v {
+			sets[u][v] = struct{}{}
+			sets[v][u] = struct{}{}
+		}
+	}
+	res := make(map[string][]string, len(sets))
+	for v, set := range sets {
+		res[v] = make([]string, 0, len(set))
+		for u := range set {
+			res[v] = append(res[v], u)
+		}
+		slices.Sort(res[v])
+	}
+	return res
+}
+
+// product builds a product of g and h; adjacent reports whether (g1, h1)
+// and (g2, h2) are adjacent given the adjacency of the coordinates. In all
+// products below g1 and g2 must be equal or adjacent, so other pairs are
+// skipped without asking.
+func product(g, h Graph, adjacent func(gEq, gAdj, hEq, hAdj bool) bool) *BasicGraph {
+	gAdj, hAdj := simpleNeighbors(g), simpleNeighbors(h)
+	gVertices, hVertices := g.SortedVertices(), h.SortedVertices()
+	isAdj := func(adj map[string][]string, u, v string) bool {
+		_, found := slices.BinarySearch(adj[u], v)
+		return found
+	}
+
+	res := NewBasicGraphWithPolicy(SimplePolicy)
+	for _, g1 := range gVertices {
+		for _, h1 := range hVertices {
+			res.AddVertex(ProductVertex(g1, h1))
+		}
+	}
+	for i, g1 := range gVertices {
+		for _, g2 := range gVertices[i:] {
+			gEq, gA := g1 == g2, isAdj(gAdj, g1, g2)
+			if !gEq && !gA {
+				continue
+			}
+			for j, h1 := range hVertices {
+				k := 0
+				if gEq {
+					k = j + 1
+				}
+				for _, h2 := range hVertices[k:] {
+					if adjacent(gEq, gA, h1 == h2, isAdj(hAdj, h1, h2)) {
+						res.AddEdge(ProductVertex(g1, h1), ProductVertex(g2, h2))
+					}
+				}
+			}
+		}
+	}
+	return res
+}
+
+// CartesianProduct returns g □ h: (g1, h1) ~ (g2, h2) if one coordinate is
+// equal and the other one adjacent. Paths give grids, K2 powers give
+// hypercubes and Kn □ Km gives rook graphs.
+func CartesianProduct(g, h Graph) *BasicGraph {
+	return product(g, h, func(gEq, gAdj, hEq, hAdj bool) bool {
+		return (gEq && hAdj) || (gAdj && hEq)
+	})
+}
+
+// TensorProduct returns g × h: (g1, h1) ~ (g2, h2) if both coordinates are
+// adjacent.
+func TensorProduct(g, h Graph) *BasicGraph {
+	return product(g, h, func(gEq, gAdj, hEq, hAdj bool) bool {
+		return gAdj && hAdj
+	})
+}
+
+// StrongProduct returns g ⊠ h, the union of the Cartesian and the tensor
+// products.
+func StrongProduct(g, h Graph) *BasicGraph {
+	return product(g, h, func(gEq, gAdj, hEq, hAdj bool) bool {
+		return (gEq || gAdj) && (hEq || hAdj) && !(gEq && hEq)
+	})
+}
+
+// LexicographicProduct returns g[h]: (g1, h1) ~ (g2, h2) if g1 ~ g2, or
+// g1 = g2 and h1 ~ h2.
+func LexicographicProduct(g, h Graph) *BasicGraph {
+	return product(g, h, func(gEq, gAdj, hEq, hAdj bool) bool {
+		return gAdj || (gEq && hAdj)
+	})
+}
+
+// DisjointUnion returns the union of copies of g and h; vertex v of g is
+// named ProductVertex("0", v) and vertex v of h ProductVertex("1", v).
+func DisjointUnion(g, h Graph) *BasicGraph {
+	res := NewBasicGraphWithPolicy(SimplePolicy)
+	for i, part := range []Graph{g, h} {
+		tag := strconv.Itoa(i)
+		adj := simpleNeighbors(part)
+		for _, v := range part.SortedVertices() {
+			res.AddVertex(ProductVertex(tag, v))
+			for _, u := range adj[v] {
+				res.AddEdge(ProductVertex(tag, v), ProductVertex(tag, u))
+			}
+		}
+	}
+	return res
+}
+
+// Join returns the disjoint union of g and h with every vertex of g joined
+// to every vertex of h. Vertices are named as in DisjointUnion.
+func Join(g, h Graph) *BasicGraph {
+	res := DisjointUnion(g, h)
+	for u := range g.AllVertices() {
+		for v := range h.AllVertices() {
+			res.AddEdge(ProductVertex("0", u), ProductVertex("1", v))
+		}
+	}
+	return res
+}
+
+// Complement returns the graph on the vertices of g whose edges are the
+// pairs of distinct vertices not adjacent in g.
+func Complement(g Graph) *BasicGraph {
+	adj := simpleNeighbors(g)
+	vertices := g.SortedVertices()
+	res := NewBasicGraphWithPolicy(SimplePolicy)
+	for i, u := range vertices {
+		res.AddVertex(u)
+		for _, v := range vertices[i+1:] {
+			if _, found := slices.BinarySearch(adj[u], v); !found {
+				res.AddEdge(u, v)
+			}
+		}
+	}
+	return res
+}
+
+// LineGraph returns the line graph of g together with the edges of g:
+// vertex strconv.Itoa(i) of the line graph stands for edges[i], and two
+// vertices are adjacent if their edges share an endpoint.
+//
+// Unlike the other operators LineGraph keeps parallel edges and
+// self-loops of g as separate vertices. The edges are listed like
+// SortedPairs(g.Edges()), so for multigraphs without self-loops they are
+// in the order of multigraph_algo.EdgeList.
+func LineGraph(g Graph) (*BasicGraph, [][2]string) {
+	edges := make([][2]string, 0)
+	incident := make(map[string][]int)
+	for u, v := range SortedPairs(g.Edges()) {
+		id := len(edges)
+		edges = append(edges, [2]string{u, v})
+		incident[u] = append(incident[u], id)
+		if v != u {
+			incident[v] = append(incident[v], id)
+		}
+	}
+
+	res := NewBasicGraphWithPolicy(SimplePolicy)
+	for id := range edges {
+		res.AddVertex(strconv.Itoa(id))
+	}
+	for _, ids := range incident {
+		for i, a := range ids {
+			for _, b := range ids[i+1:] {
+				res.AddEdge(strconv.Itoa(a), strconv.Itoa(b))
+			}
+		}
+	}
+	return res, edges
+}
@@ -0,0 +1,116 @@
+package graphs
+
+import (
+	"slices"
+	"strconv"
+	"testing"
+)
+
+func pathGraph(n int) *BasicGraph {
+	g := NewBasicGraph()
+	g.AddVertex("0")
+	for i := 1; i < n; i++ {
+		g.AddEdge(strconv.Itoa(i-1), strconv.Itoa(i))
+	}
+	return g
+}
+
+func completeGraph(n int) *BasicGraph {
+	g := NewBasicGraph()
+	for i := range n {
+		g.AddVertex(strconv.Itoa(i))
+		for j := range i {
+			g.AddEdge(strconv.Itoa(j), strconv.Itoa(i))
+		}
+	}
+	return g
+}
+
+func cycleGraph(n int) *BasicGraph {
+	g := pathGraph(n)
+	g.AddEdge(strconv.Itoa(n-1), "0")
+	return g
+}
+
+func checkRegular(t *testing.T, g Graph, vertices, edges, degree int) {
+	t.Helper()
+	if g.VertexCount() != vertices || g.EdgeCount() != edges {
+		t.Fatalf("Expected %d vertices and %d edges, got %d and %d", vertices, edges, g.VertexCount(), g.EdgeCount())
+	}
+	for v := range g.AllVertices() {
+		if d := g.Degree(v); degree >= 0 && d != degree {
+			t.Fatalf("Expected degree %d for %s, got %d", degree, v, d)
+		}
+	}
+}
+
+func TestCartesianProduct(t *testing.T) {
+	grid := CartesianProduct(pathGraph(3), pathGraph(4))
+	checkRegular(t, grid, 12, 17, -1)
+	if !grid.HasEdge(ProductVertex("1", "2"), ProductVertex("1", "3")) || grid.HasEdge(ProductVertex("0", "0"), ProductVertex("1", "1")) {
+		t.Errorf("Unexpected grid edges")
+	}
+
+	cube := completeGraph(2)
+	for range 2 {
+		cube = CartesianProduct(cube, completeGraph(2))
+	}
+	checkRegular(t, cube, 8, 12, 3)
+
+	checkRegular(t, CartesianProduct(completeGraph(3), completeGraph(3)), 9, 18, 4)
+}
+
+func TestProductVertexNamesAreUnique(t *testing.T) {
+	if ProductVertex("a,b", "c") == ProductVertex("a", "b,c") || ProductVertex("a)", "b") == ProductVertex("a", ")b") {
+		t.Errorf("Expected different names for different pairs")
+	}
+	if got := ProductVertex(`a\`, "(b)"); got != `(a\\,\(b\))` {
+		t.Errorf("Unexpected name %s", got)
+	}
+
+	g, h := NewBasicGraph(), NewBasicGraph()
+	g.AddEdge("a", "a,b")
+	h.AddEdge("c", "b,c")
+	if product := CartesianProduct(g, h); product.VertexCount() != 4 || product.EdgeCount() != 4 {
+		t.Errorf("Expected a 4-cycle, got %v", product.AdjacencyLists())
+	}
+}
+
+func TestTensorStrongLexicographicProducts(t *testing.T) {
+	checkRegular(t, TensorProduct(completeGraph(2), completeGraph(3)), 6, 6, 2)
+	checkRegular(t, StrongProduct(pathGraph(2), pathGraph(2)), 4, 6, 3)
+	checkRegular(t, LexicographicProduct(completeGraph(2), Complement(completeGraph(2))), 4, 4, 2)
+	checkRegular(t, LexicographicProduct(cycleGraph(4), completeGraph(2)), 8, 20, 5)
+}
+
+func TestDisjointUnionJoinComplement(t *testing.T) {
+	union := DisjointUnion(completeGraph(3), completeGraph(3))
+	checkRegular(t, union, 6, 6, 2)
+
+	wheel := Join(completeGraph(1), cycleGraph(4))
+	checkRegular(t, wheel, 5, 8, -1)
+	if d := wheel.Degree(ProductVertex("0", "0")); d != 4 {
+		t.Errorf("Expected hub degree 4, got %d", d)
+	}
+
+	checkRegular(t, Complement(cycleGraph(5)), 5, 5, 2)
+	checkRegular(t, Complement(completeGraph(4)), 4, 0, 0)
+}
+
+func TestLineGraph(t *testing.T) {
+	line, edges := LineGraph(completeGraph(3))
+	checkRegular(t, line, 3, 3, 2)
+	if !slices.Equal(edges, [][2]string{{"0", "1"}, {"0", "2"}, {"1", "2"}}) {
+		t.Errorf("Unexpected edges %v", edges)
+	}
+
+	multi := NewMultiGraph()
+	multi.AddEdge("a", "b")
+	multi.AddEdge("a", "b")
+	multi.AddEdge("b", "c")
+	line, edges = LineGraph(multi)
+	checkRegular(t, line, 3, 3, 2)
+	if !slices.Equal(edges, [][2]string{{"a", "b"}, {"a", "b"}, {"b", "c"}}) {
+		t.Errorf("Unexpected edges %v", edges)
+	}
+}
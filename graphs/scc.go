@@ -0,0 +1,293 @@
+package graphs
+
+import (
+	"container/heap"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// The functions below follow the arcs of directed graphs; an undirected
+// edge counts as a pair of opposite arcs. Components are returned with
+// sorted vertices and ordered by their smallest vertex, so Tarjan's and
+// Kosaraju's algorithms give identical results.
+
+// directedAdjacency numbers the vertices of g in sorted order and returns
+// their names and sorted lists of out-neighbors without repetitions.
+func directedAdjacency(g Graph) ([]string, [][]int) {
+	names := g.SortedVertices()
+	index := make(map[string]int, len(names))
+	for i, v := range names {
+		index[v] = i
+	}
+	adj := make([][]int, len(names))
+	for i, v := range names {
+		for u := range g.Neighbors(v) {
+			adj[i] = append(adj[i], index[u])
+		}
+		slices.Sort(adj[i])
+		adj[i] = slices.Compact(adj[i])
+	}
+	return names, adj
+}
+
+// groupComponents turns the component number of every vertex into sorted
+// vertex lists ordered by their smallest vertex.
+func groupComponents(names []string, comp []int, count int) [][]string {
+	res := make([][]string, count)
+	for v, c := range comp {
+		res[c] = append(res[c], names[v])
+	}
+	slices.SortFunc(res, slices.Compare)
+	return res
+}
+
+// TarjanSCC returns the strongly connected components of g found by
+// Tarjan's single-pass algorithm.
+func TarjanSCC(g Graph) [][]string {
+	names, adj := directedAdjacency(g)
+	n := len(names)
+	disc, low, comp := make([]int, n), make([]int, n), make([]int, n)
+	for v := range disc {
+		disc[v], comp[v] = -1, -1
+	}
+	type frame struct{ v, next int }
+	// open holds the visited vertices whose component is not known yet.
+	open := make([]int, 0)
+	timer, count := 0, 0
+	for root := range n {
+		if disc[root] >= 0 {
+			continue
+		}
+		stack := []frame{{v: root}}
+		disc[root], low[root] = timer, timer
+		timer++
+		open = append(open, root)
+		for len(stack) > 0 {
+			f := &stack[len(stack)-1]
+			if f.next < len(adj[f.v]) {
+				w := adj[f.v][f.next]
+				f.next++
+				if disc[w] < 0 {
+					disc[w], low[w] = timer, timer
+					timer++
+					open = append(open, w)
+					stack = append(stack, frame{v: w})
+				} else if comp[w] < 0 {
+					low[f.v] = min(low[f.v], disc[w])
+				}
+				continue
+			}
+
+			v := f.v
+			stack = stack[:len(stack)-1]
+			if len(stack) > 0 {
+				parent := stack[len(stack)-1].v
+				low[parent] = min(low[parent], low[v])
+			}
+			if low[v] == disc[v] {
+				for {
+					w := open[len(open)-1]
+					open = open[:len(open)-1]
+					comp[w] = count
+					if w == v {
+						break
+					}
+				}
+				count++
+			}
+		}
+	}
+	return groupComponents(names, comp, count)
+}
+
+// KosarajuSCC returns the strongly connected components of g found by
+// Kosaraju's algorithm: a search of g orders the vertices by finishing
+// time, and searches of the reversed graph in the opposite order collect
+// one component each.
+func KosarajuSCC(g Graph) [][]string {
+	names, adj := directedAdjacency(g)
+	n := len(names)
+	reversed := make([][]int, n)
+	for v, neighbors := range adj {
+		for _, w := range neighbors {
+			reversed[w] = append(reversed[w], v)
+		}
+	}
+
+	type frame struct{ v, next int }
+	seen := make([]bool, n)
+	finished := make([]int, 0, n)
+	for root := range n {
+		if seen[root] {
+			continue
+		}
+		seen[root] = true
+		stack := []frame{{v: root}}
+		for len(stack) > 0 {
+			f := &stack[len(stack)-1]
+			if f.next < len(adj[f.v]) {
+				w := adj[f.v][f.next]
+				f.next++
+				if !seen[w] {
+					seen[w] = true
+					stack = append(stack, frame{v: w})
+				}
+				continue
+			}
+			finished = append(finished, f.v)
+			stack = stack[:len(stack)-1]
+		}
+	}
+
+	comp := make([]int, n)
+	for v := range comp {
+		comp[v] = -1
+	}
+	count := 0
+	for _, root := range slices.Backward(finished) {
+		if comp[root] >= 0 {
+			continue
+		}
+		comp[root] = count
+		queue := []int{root}
+		for i := 0; i < len(queue); i++ {
+			for _, w := range reversed[queue[i]] {
+				if comp[w] < 0 {
+					comp[w] = count
+					queue = append(queue, w)
+				}
+			}
+		}
+		count++
+	}
+	return groupComponents(names, comp, count)
+}
+
+// Condensation returns the DAG of the strongly connected components of g
+// and the component of every vertex. Component i is the vertex
+// strconv.Itoa(i) of the DAG and the i-th element of TarjanSCC(g); the DAG
+// has an arc between two components if g has an arc between their
+// vertices. It has no self-loops or parallel arcs.
+func Condensation(g Graph) (*DirectedGraph, map[string]int) {
+	components := TarjanSCC(g)
+	comp := make(map[string]int, g.VertexCount())
+	dag := NewDirectedGraphWithPolicy(SimplePolicy)
+	for i, vertices := range components {
+		dag.AddVertex(strconv.Itoa(i))
+		for _, v := range vertices {
+			comp[v] = i
+		}
+	}
+	for u, v := range g.Edges() {
+		dag.AddEdge(strconv.Itoa(comp[u]), strconv.Itoa(comp[v]))
+		if !g.Directed() {
+			dag.AddEdge(strconv.Itoa(comp[v]), strconv.Itoa(comp[u]))
+		}
+	}
+	return dag, comp
+}
+
+// CycleError reports that a graph has a directed cycle: an arc leads from
+// every vertex of Cycle to the next one and from the last one to the first.
+type CycleError struct {
+	Cycle []string
+}
+
+func (e *CycleError) Error() string {
+	return fmt.Sprintf("graph has a cycle: %s -> %s", strings.Join(e.Cycle, " -> "), e.Cycle[0])
+}
+
+// stringHeap is a min-heap of vertex names.
+type stringHeap []string
+
+func (h stringHeap) Len() int           { return len(h) }
+func (h stringHeap) Less(i, j int) bool { return h[i] < h[j] }
+func (h stringHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *stringHeap) Push(x any)        { *h = append(*h, x.(string)) }
+func (h *stringHeap) Pop() any {
+	old := *h
+	x := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return x
+}
+
+// TopologicalSort orders the vertices of g so that every arc leads forward.
+// Of all such orders it returns the lexicographically smallest one. If g
+// has a cycle, it returns a *CycleError with one of the cycles instead.
+func TopologicalSort(g Graph) ([]string, error) {
+	names, adj := directedAdjacency(g)
+	n := len(names)
+	indegree := make([]int, n)
+	for _, neighbors := range adj {
+		for _, w := range neighbors {
+			indegree[w]++
+		}
+	}
+	index := make(map[string]int, n)
+	ready := &stringHeap{}
+	for v, name := range names {
+		index[name] = v
+		if indegree[v] == 0 {
+			*ready = append(*ready, name)
+		}
+	}
+	heap.Init(ready)
+
+	order := make([]string, 0, n)
+	for ready.Len() > 0 {
+		name := heap.Pop(ready).(string)
+		order = append(order, name)
+		for _, w := range adj[index[name]] {
+			indegree[w]--
+			if indegree[w] == 0 {
+				heap.Push(ready, names[w])
+			}
+		}
+	}
+	if len(order) == n {
+		return order, nil
+	}
+	return nil, &CycleError{findCycle(names, adj, indegree)}
+}
+
+// findCycle returns a cycle among the vertices left with positive indegree
+// by Kahn's algorithm. Each of them has a predecessor among them, so
+// walking backwards from one of them must come back to a visited vertex.
+func findCycle(names []string, adj [][]int, indegree []int) []string {
+	pred := make([]int, len(names))
+	for v := range pred {
+		pred[v] = -1
+	}
+	for v, neighbors := range adj {
+		if indegree[v] == 0 {
+			continue
+		}
+		for _, w := range neighbors {
+			if indegree[w] > 0 && pred[w] < 0 {
+				pred[w] = v
+			}
+		}
+	}
+
+	start := slices.IndexFunc(indegree, func(d int) bool { return d > 0 })
+	step := make([]int, len(names))
+	walk := make([]int, 0)
+	v := start
+	for step[v] == 0 {
+		walk = append(walk, v)
+		step[v] = len(walk)
+		v = pred[v]
+	}
+	// The walk goes against the arcs; its tail from v is the reversed cycle.
+	// Vertex numbers follow names, so the cycle starts at its smallest name.
+	cycle := walk[step[v]-1:]
+	slices.Reverse(cycle)
+	first := slices.Index(cycle, slices.Min(cycle))
+	res := make([]string, 0, len(cycle))
+	for _, u := range slices.Concat(cycle[first:], cycle[:first]) {
+		res = append(res, names[u])
+	}
+	return res
+}
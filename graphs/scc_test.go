@@ -0,0 +1,102 @@
+package graphs
+
+import (
+	"errors"
+	"reflect"
+	"slices"
+	"strconv"
+	"testing"
+)
+
+// sccGraph has the components {A, B, C}, {D, E}, {F} and {G}.
+func sccGraph() *DirectedGraph {
+	g := NewDirectedGraph()
+	for _, e := range [][2]string{{"A", "B"}, {"B", "C"}, {"C", "A"}, {"C", "D"}, {"D", "E"}, {"E", "D"}, {"E", "F"}, {"B", "F"}} {
+		g.AddEdge(e[0], e[1])
+	}
+	g.AddVertex("G")
+	return g
+}
+
+func TestStronglyConnectedComponents(t *testing.T) {
+	expected := [][]string{{"A", "B", "C"}, {"D", "E"}, {"F"}, {"G"}}
+	for name, scc := range map[string]func(Graph) [][]string{"Tarjan": TarjanSCC, "Kosaraju": KosarajuSCC} {
+		if got := scc(sccGraph()); !reflect.DeepEqual(got, expected) {
+			t.Errorf("%s: expected %v, got %v", name, expected, got)
+		}
+	}
+
+	w := NewWeightedOrientedGraph()
+	w.AddEdge("A", "B", 1)
+	w.AddEdge("B", "A", 2)
+	w.AddEdge("B", "C", 3)
+	if got := KosarajuSCC(w); !reflect.DeepEqual(got, [][]string{{"A", "B"}, {"C"}}) {
+		t.Errorf("Unexpected components %v", got)
+	}
+}
+
+func TestSCCAlgorithmsAgree(t *testing.T) {
+	g := NewDirectedGraph()
+	for i := range 300 {
+		g.AddEdge(strconv.Itoa(i), strconv.Itoa(i*7%300))
+		g.AddEdge(strconv.Itoa(i), strconv.Itoa((i*i+3)%300))
+	}
+	if a, b := TarjanSCC(g), KosarajuSCC(g); !reflect.DeepEqual(a, b) {
+		t.Errorf("Tarjan and Kosaraju disagree: %d and %d components", len(a), len(b))
+	}
+}
+
+func TestCondensation(t *testing.T) {
+	dag, comp := Condensation(sccGraph())
+	if comp["A"] != 0 || comp["C"] != 0 || comp["E"] != 1 || comp["F"] != 2 || comp["G"] != 3 {
+		t.Errorf("Unexpected components %v", comp)
+	}
+	if dag.VertexCount() != 4 || dag.EdgeCount() != 3 {
+		t.Errorf("Expected 4 components and 3 arcs, got %d and %d", dag.VertexCount(), dag.EdgeCount())
+	}
+	if !dag.HasEdge("0", "1") || !dag.HasEdge("0", "2") || !dag.HasEdge("1", "2") {
+		t.Errorf("Unexpected condensation arcs")
+	}
+	if _, err := TopologicalSort(dag); err != nil {
+		t.Errorf("Condensation must be acyclic: %v", err)
+	}
+}
+
+func TestTopologicalSort(t *testing.T) {
+	g := NewDirectedGraph()
+	for _, e := range [][2]string{{"shirt", "tie"}, {"tie", "jacket"}, {"pants", "shoes"}, {"pants", "belt"}, {"belt", "jacket"}, {"socks", "shoes"}} {
+		g.AddEdge(e[0], e[1])
+	}
+	order, err := TopologicalSort(g)
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	expected := []string{"pants", "belt", "shirt", "socks", "shoes", "tie", "jacket"}
+	if !slices.Equal(order, expected) {
+		t.Errorf("Expected %v, got %v", expected, order)
+	}
+}
+
+func TestTopologicalSortCycle(t *testing.T) {
+	g := NewDirectedGraph()
+	for _, e := range [][2]string{{"A", "B"}, {"B", "C"}, {"C", "D"}, {"D", "B"}, {"D", "E"}} {
+		g.AddEdge(e[0], e[1])
+	}
+	_, err := TopologicalSort(g)
+	var cycleErr *CycleError
+	if !errors.As(err, &cycleErr) {
+		t.Fatalf("Expected a CycleError, got %v", err)
+	}
+	if !slices.Equal(cycleErr.Cycle, []string{"B", "C", "D"}) {
+		t.Errorf("Expected the cycle B -> C -> D, got %v", cycleErr.Cycle)
+	}
+	if err.Error() != "graph has a cycle: B -> C -> D -> B" {
+		t.Errorf("Unexpected message %q", err)
+	}
+
+	g.AddEdge("E", "E")
+	g.RemoveEdge("D", "B")
+	if _, err := TopologicalSort(g); !errors.As(err, &cycleErr) || !slices.Equal(cycleErr.Cycle, []string{"E"}) {
+		t.Errorf("Expected the self-loop at E, got %v", err)
+	}
+}
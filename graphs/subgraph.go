@@ -0,0 +1,264 @@
+package graphs
+
+import (
+	"iter"
+	"slices"
+)
+
+// subgraph is a read-only view of the part of g made of the vertices
+// accepted by keepVertex and the edges between them accepted by keepEdge.
+//
+// A view costs no copying, but every query goes through the filters, so
+// counting vertices or edges takes a pass over g. Views do not carry
+// attributes; convert a view (e.g. with ToBasicGraph) to get an
+// independent graph.
+type subgraph struct {
+	g          Graph
+	keepVertex func(v string) bool
+	keepEdge   func(u, v string) bool
+}
+
+// newView wraps the view so that it keeps the WeightedOf and Multi
+// capabilities of g. Weights of the predeclared integer and float types are
+// kept; graphs with weights of other named types get an unweighted view.
+func newView(s *subgraph) Graph {
+	switch s.g.(type) {
+	case WeightedOf[int]:
+		return &weightedSubgraph[int]{s}
+	case WeightedOf[int8]:
+		return &weightedSubgraph[int8]{s}
+	case WeightedOf[int16]:
+		return &weightedSubgraph[int16]{s}
+	case WeightedOf[int32]:
+		return &weightedSubgraph[int32]{s}
+	case WeightedOf[int64]:
+		return &weightedSubgraph[int64]{s}
+	case WeightedOf[uint]:
+		return &weightedSubgraph[uint]{s}
+	case WeightedOf[uint8]:
+		return &weightedSubgraph[uint8]{s}
+	case WeightedOf[uint16]:
+		return &weightedSubgraph[uint16]{s}
+	case WeightedOf[uint32]:
+		return &weightedSubgraph[uint32]{s}
+	case WeightedOf[uint64]:
+		return &weightedSubgraph[uint64]{s}
+	case WeightedOf[float32]:
+		return &weightedSubgraph[float32]{s}
+	case WeightedOf[float64]:
+		return &weightedSubgraph[float64]{s}
+	case Multi:
+		return &multiSubgraph{s}
+	default:
+		return s
+	}
+}
+
+func setOf(vertices []string) map[string]struct{} {
+	res := make(map[string]struct{}, len(vertices))
+	for _, v := range vertices {
+		res[v] = struct{}{}
+	}
+	return res
+}
+
+// InducedSubgraph returns a view of the subgraph induced by vertices: those
+// of them that belong to g and all edges of g between them.
+func InducedSubgraph(g Graph, vertices []string) Graph {
+	set := setOf(vertices)
+	return newView(&subgraph{
+		g: g,
+		keepVertex: func(v string) bool {
+			_, exists := set[v]
+			return exists
+		},
+	})
+}
+
+// DeleteVertices returns a view of g without the given vertices and their
+// edges.
+func DeleteVertices(g Graph, vertices ...string) Graph {
+	set := setOf(vertices)
+	return newView(&subgraph{
+		g: g,
+		keepVertex: func(v string) bool {
+			_, exists := set[v]
+			return !exists
+		},
+	})
+}
+
+// EdgeSubgraph returns a view made of the given edges of g and their
+// endpoints. Pairs that are not edges of g are ignored; all parallel edges
+// of a listed pair are kept. For undirected graphs (u, v) and (v, u) name
+// the same edge.
+func EdgeSubgraph(g Graph, edges [][2]string) Graph {
+	directed := g.Directed()
+	pairs := make(map[[2]string]struct{}, len(edges))
+	endpoints := make(map[string]struct{})
+	for _, e := range edges {
+		if !g.HasEdge(e[0], e[1]) {
+			continue
+		}
+		if !directed && e[0] > e[1] {
+			e[0], e[1] = e[1], e[0]
+		}
+		pairs[e] = struct{}{}
+		endpoints[e[0]] = struct{}{}
+		endpoints[e[1]] = struct{}{}
+	}
+	return newView(&subgraph{
+		g: g,
+		keepVertex: func(v string) bool {
+			_, exists := endpoints[v]
+			return exists
+		},
+		keepEdge: func(u, v string) bool {
+			if !directed && u > v {
+				u, v = v, u
+			}
+			_, exists := pairs[[2]string{u, v}]
+			return exists
+		},
+	})
+}
+
+func (s *subgraph) edgeKept(u, v string) bool {
+	return s.keepVertex(u) && s.keepVertex(v) && (s.keepEdge == nil || s.keepEdge(u, v))
+}
+
+func (s *subgraph) Directed() bool {
+	return s.g.Directed()
+}
+
+func (s *subgraph) HasVertex(vertex string) bool {
+	return s.keepVertex(vertex) && s.g.HasVertex(vertex)
+}
+
+func (s *subgraph) VertexCount() int {
+	count := 0
+	for range s.AllVertices() {
+		count++
+	}
+	return count
+}
+
+func (s *subgraph) EdgeCount() int {
+	count := 0
+	for range s.Edges() {
+		count++
+	}
+	return count
+}
+
+func (s *subgraph) SortedVertices() []string {
+	return slices.Sorted(s.AllVertices())
+}
+
+func (s *subgraph) AllVertices() iter.Seq[string] {
+	return func(yield func(string) bool) {
+		for v := range s.g.AllVertices() {
+			if s.keepVertex(v) && !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+func (s *subgraph) Neighbors(vertex string) iter.Seq[string] {
+	return func(yield func(string) bool) {
+		if !s.keepVertex(vertex) {
+			return
+		}
+		for v := range s.g.Neighbors(vertex) {
+			if s.edgeKept(vertex, v) && !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+func (s *subgraph) Edges() iter.Seq2[string, string] {
+	return func(yield func(string, string) bool) {
+		for u, v := range s.g.Edges() {
+			if s.edgeKept(u, v) && !yield(u, v) {
+				return
+			}
+		}
+	}
+}
+
+func (s *subgraph) IncidentEdges(vertex string) iter.Seq2[string, string] {
+	return func(yield func(string, string) bool) {
+		if !s.keepVertex(vertex) {
+			return
+		}
+		for u, v := range s.g.IncidentEdges(vertex) {
+			if s.edgeKept(u, v) && !yield(u, v) {
+				return
+			}
+		}
+	}
+}
+
+// Degree counts parallel edges with their multiplicity; an undirected
+// self-loop adds 2.
+func (s *subgraph) Degree(vertex string) int {
+	degree := 0
+	for u, v := range s.IncidentEdges(vertex) {
+		degree++
+		if u == v && !s.Directed() {
+			degree++
+		}
+	}
+	return degree
+}
+
+func (s *subgraph) HasEdge(vertex1, vertex2 string) bool {
+	return s.edgeKept(vertex1, vertex2) && s.g.HasEdge(vertex1, vertex2)
+}
+
+type weightedSubgraph[W Weight] struct {
+	*subgraph
+}
+
+func (s *weightedSubgraph[W]) WeightedNeighbors(vertex string) iter.Seq2[string, W] {
+	return func(yield func(string, W) bool) {
+		if !s.keepVertex(vertex) {
+			return
+		}
+		for v, w := range s.g.(WeightedOf[W]).WeightedNeighbors(vertex) {
+			if s.edgeKept(vertex, v) && !yield(v, w) {
+				return
+			}
+		}
+	}
+}
+
+func (s *weightedSubgraph[W]) WeightedEdges() iter.Seq[WeightedEdgeOf[string, W]] {
+	return func(yield func(WeightedEdgeOf[string, W]) bool) {
+		for e := range s.g.(WeightedOf[W]).WeightedEdges() {
+			if s.edgeKept(e.U, e.V) && !yield(e) {
+				return
+			}
+		}
+	}
+}
+
+func (s *weightedSubgraph[W]) GetEdgeWeight(vertex1, vertex2 string) (W, bool) {
+	if !s.edgeKept(vertex1, vertex2) {
+		return 0, false
+	}
+	return s.g.(WeightedOf[W]).GetEdgeWeight(vertex1, vertex2)
+}
+
+type multiSubgraph struct {
+	*subgraph
+}
+
+func (s *multiSubgraph) Multiplicity(vertex1, vertex2 string) int {
+	if !s.edgeKept(vertex1, vertex2) {
+		return 0
+	}
+	return s.g.(Multi).Multiplicity(vertex1, vertex2)
+}
@@ -0,0 +1,135 @@
+package graphs
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestInducedSubgraph(t *testing.T) {
+	graph := NewBasicGraph()
+	graph.AddEdge("A", "B")
+	graph.AddEdge("B", "C")
+	graph.AddEdge("C", "A")
+	graph.AddEdge("C", "D")
+	graph.AddEdge("C", "C")
+
+	sub := InducedSubgraph(graph, []string{"A", "C", "D", "X"})
+	if got := sub.SortedVertices(); !slices.Equal(got, []string{"A", "C", "D"}) {
+		t.Errorf("Expected vertices [A C D], got %v", got)
+	}
+	if sub.HasEdge("A", "B") || !sub.HasEdge("A", "C") {
+		t.Errorf("Expected only edges between kept vertices")
+	}
+	if n := sub.EdgeCount(); n != 3 {
+		t.Errorf("Expected 3 edges, got %d", n)
+	}
+	if d := sub.Degree("C"); d != 4 {
+		t.Errorf("Expected degree 4 for C, got %d", d)
+	}
+	if got := slices.Sorted(sub.Neighbors("C")); !slices.Equal(got, []string{"A", "C", "D"}) {
+		t.Errorf("Expected neighbors [A C D] of C, got %v", got)
+	}
+	if _, multi := sub.(Multi); !multi {
+		t.Errorf("Expected the view of a BasicGraph to be Multi")
+	}
+
+	// The view follows changes of the graph.
+	graph.AddEdge("A", "D")
+	if !sub.HasEdge("D", "A") {
+		t.Errorf("Expected the view to see the new edge")
+	}
+}
+
+func TestDeleteVertices(t *testing.T) {
+	graph := NewWeightedGraph()
+	graph.AddEdge("A", "B", 1)
+	graph.AddEdge("B", "C", 2)
+	graph.AddEdge("C", "A", 3)
+
+	sub := DeleteVertices(graph, "B")
+	weighted, ok := sub.(Weighted)
+	if !ok {
+		t.Fatalf("Expected the view of a WeightedGraph to be Weighted")
+	}
+	if w, ok := weighted.GetEdgeWeight("A", "C"); !ok || w != 3 {
+		t.Errorf("Expected weight 3 on A-C, got %v, %v", w, ok)
+	}
+	if _, ok := weighted.GetEdgeWeight("A", "B"); ok {
+		t.Errorf("Expected edge A-B to be hidden")
+	}
+	if n := len(slices.Collect(weighted.WeightedEdges())); n != 1 {
+		t.Errorf("Expected 1 edge, got %d", n)
+	}
+	if sub.VertexCount() != 2 || graph.VertexCount() != 3 {
+		t.Errorf("Expected the view to hide B without changing the graph")
+	}
+}
+
+func TestEdgeSubgraph(t *testing.T) {
+	multi := NewMultiGraph()
+	multi.AddEdge("A", "B")
+	multi.AddEdge("A", "B")
+	multi.AddEdge("B", "C")
+	multi.AddEdge("C", "D")
+
+	sub := EdgeSubgraph(multi, [][2]string{{"B", "A"}, {"C", "D"}, {"A", "D"}})
+	if got := sub.SortedVertices(); !slices.Equal(got, []string{"A", "B", "C", "D"}) {
+		t.Errorf("Expected vertices [A B C D], got %v", got)
+	}
+	if sub.HasEdge("B", "C") {
+		t.Errorf("Expected edge B-C to be left out")
+	}
+	if m := sub.(Multi).Multiplicity("A", "B"); m != 2 {
+		t.Errorf("Expected multiplicity 2 for A-B, got %d", m)
+	}
+	if n := sub.EdgeCount(); n != 3 {
+		t.Errorf("Expected 3 edges, got %d", n)
+	}
+
+	directed := NewDirectedGraph()
+	directed.AddEdge("A", "B")
+	directed.AddEdge("B", "A")
+	arcs := EdgeSubgraph(directed, [][2]string{{"A", "B"}})
+	if !arcs.HasEdge("A", "B") || arcs.HasEdge("B", "A") {
+		t.Errorf("Expected only the arc A->B")
+	}
+}
+
+func TestSubgraphKeepsFloatWeights(t *testing.T) {
+	graph := NewWeightedGraphOf[string, float64]()
+	graph.AddEdge("A", "B", 0.5)
+	graph.AddEdge("B", "C", 1.5)
+	graph.AddEdge("A", "C", 2.5)
+
+	for name, sub := range map[string]Graph{
+		"induced": InducedSubgraph(graph, []string{"A", "B"}),
+		"edges":   EdgeSubgraph(graph, [][2]string{{"B", "A"}}),
+	} {
+		weighted, ok := sub.(WeightedOf[float64])
+		if !ok {
+			t.Fatalf("%s: expected the view to keep float64 weights", name)
+		}
+		if w, ok := weighted.GetEdgeWeight("A", "B"); !ok || w != 0.5 {
+			t.Errorf("%s: expected weight 0.5 for A-B, got %v", name, w)
+		}
+		if _, ok := weighted.GetEdgeWeight("A", "C"); ok {
+			t.Errorf("%s: expected edge A-C to be left out", name)
+		}
+		if n := len(slices.Collect(weighted.WeightedEdges())); n != 1 {
+			t.Errorf("%s: expected 1 edge, got %d", name, n)
+		}
+	}
+}
+
+func TestSubgraphConversion(t *testing.T) {
+	graph := NewBasicGraph()
+	graph.AddEdge("A", "B")
+	graph.AddEdge("B", "C")
+
+	copied := ToBasicGraph(DeleteVertices(graph, "C"), ConvertOptions{})
+	expected := NewBasicGraph()
+	expected.AddEdge("A", "B")
+	if !copied.Equal(expected) {
+		t.Errorf("Expected a copy of the view, diff %+v", copied.Diff(expected))
+	}
+}
@@ -1,55 +1,86 @@
 package graphs
 
-type WeightedGraph struct {
-	Vertices map[string]map[string]int
+import (
+	"iter"
+	"maps"
+	"slices"
+)
+
+// WeightedGraphOf is an undirected weighted graph with vertices of type N
+// and edge weights of type W.
+type WeightedGraphOf[N Vertex, W Weight] struct {
+	Vertices map[N]map[N]W
+	attrs    *AttributesOf[N]
 }
 
-// WeightedEdge represents an edge in a weighted graph.
+// WeightedGraph is the string-vertex, int-weight graph used throughout the repository.
+type WeightedGraph = WeightedGraphOf[string, int]
+
+// WeightedEdgeOf represents an edge in a weighted graph.
 //
 // Fields:
 //
-//	U: The name of the first vertex (string).
-//	V: The name of the second vertex (string).
-//	Weight: The weight of the edge (int).
-type WeightedEdge struct {
-	U      string
-	V      string
-	Weight int
+//	U: The first vertex (N).
+//	V: The second vertex (N).
+//	Weight: The weight of the edge (W).
+type WeightedEdgeOf[N Vertex, W Weight] struct {
+	U      N
+	V      N
+	Weight W
 }
 
+// WeightedEdge is an edge of a WeightedGraph.
+type WeightedEdge = WeightedEdgeOf[string, int]
+
 func NewWeightedGraph() *WeightedGraph {
-	return &WeightedGraph{
-		Vertices: make(map[string]map[string]int),
+	return NewWeightedGraphOf[string, int]()
+}
+
+func NewWeightedGraphOf[N Vertex, W Weight]() *WeightedGraphOf[N, W] {
+	return &WeightedGraphOf[N, W]{
+		Vertices: make(map[N]map[N]W),
 	}
 }
 
-func (g *WeightedGraph) AddEdge(vertex1, vertex2 string, weight int) {
+func (g *WeightedGraphOf[N, W]) AddEdge(vertex1, vertex2 N, weight W) {
 	if _, exists := g.Vertices[vertex1]; !exists {
-		g.Vertices[vertex1] = make(map[string]int)
+		g.Vertices[vertex1] = make(map[N]W)
 	}
 	if _, exists := g.Vertices[vertex2]; !exists {
-		g.Vertices[vertex2] = make(map[string]int)
+		g.Vertices[vertex2] = make(map[N]W)
 	}
 
 	g.Vertices[vertex1][vertex2] = weight
 	g.Vertices[vertex2][vertex1] = weight
 }
 
-func (g *WeightedGraph) RemoveEdge(vertex1, vertex2 string) {
+func (g *WeightedGraphOf[N, W]) RemoveEdge(vertex1, vertex2 N) {
 	delete(g.Vertices[vertex1], vertex2)
 	delete(g.Vertices[vertex2], vertex1)
+	if g.attrs != nil {
+		g.attrs.removeEdge(vertex1, vertex2)
+	}
+}
+
+// Attrs returns the vertex and edge attributes of the graph.
+func (g *WeightedGraphOf[N, W]) Attrs() *AttributesOf[N] {
+	return lazyAttrs(&g.attrs, false)
 }
 
-func (g *WeightedGraph) GetNeighbors(vertex string) map[string]int {
+func (g *WeightedGraphOf[N, W]) storedAttrs() *AttributesOf[N] {
+	return g.attrs
+}
+
+func (g *WeightedGraphOf[N, W]) GetNeighbors(vertex N) map[N]W {
 	return g.Vertices[vertex]
 }
 
-func (g *WeightedGraph) HasEdge(vertex1, vertex2 string) bool {
+func (g *WeightedGraphOf[N, W]) HasEdge(vertex1, vertex2 N) bool {
 	_, exists := g.Vertices[vertex1][vertex2]
 	return exists
 }
 
-func (g *WeightedGraph) GetEdgeWeight(vertex1, vertex2 string) (int, bool) {
+func (g *WeightedGraphOf[N, W]) GetEdgeWeight(vertex1, vertex2 N) (W, bool) {
 	weight, exists := g.Vertices[vertex1][vertex2]
 	return weight, exists
 }
@@ -57,12 +88,13 @@ func (g *WeightedGraph) GetEdgeWeight(vertex1, vertex2 string) (int, bool) {
 // Returns a slice of all edges in the graph.
 //
 // IMPORTANT: The order of edges in the returned slice is NOT guaranteed to be deterministic.
+// Use SortedEdges or ShuffledEdges when the order matters.
 //
 // The function relies on the invariant of the Vertices map (u < v for any edge (u, v))
 // to ensure that it only adds one representation of each undirected edge to the result.
-func (g *WeightedGraph) GetEdges() []WeightedEdge {
-	edges := make([]WeightedEdge, 0)
-	seen := make(map[[2]string]struct{})
+func (g *WeightedGraphOf[N, W]) GetEdges() []WeightedEdgeOf[N, W] {
+	edges := make([]WeightedEdgeOf[N, W], 0)
+	seen := make(map[[2]N]struct{})
 
 	for u := range g.Vertices {
 		for v, weight := range g.Vertices[u] {
@@ -70,14 +102,130 @@ func (g *WeightedGraph) GetEdges() []WeightedEdge {
 			if a > b {
 				a, b = b, a
 			}
-			key := [2]string{a, b}
+			key := [2]N{a, b}
 
 			if _, exists := seen[key]; !exists {
 				seen[key] = struct{}{}
-				edges = append(edges, WeightedEdge{a, b, weight})
+				edges = append(edges, WeightedEdgeOf[N, W]{a, b, weight})
 			}
 		}
 	}
 
 	return edges
 }
+
+// SortedEdges returns all edges in the canonical order: by weight, then
+// by endpoints (see CompareWeightedEdges).
+func (g *WeightedGraphOf[N, W]) SortedEdges() []WeightedEdgeOf[N, W] {
+	edges := g.GetEdges()
+	SortWeightedEdges(edges)
+	return edges
+}
+
+// ShuffledEdges returns all edges in a pseudo-random order that depends
+// only on the graph and the seed, so it is stable between runs.
+func (g *WeightedGraphOf[N, W]) ShuffledEdges(seed uint64) []WeightedEdgeOf[N, W] {
+	edges := g.GetEdges()
+	ShuffleWeightedEdges(edges, seed)
+	return edges
+}
+
+func (g *WeightedGraphOf[N, W]) Directed() bool {
+	return false
+}
+
+func (g *WeightedGraphOf[N, W]) AllVertices() iter.Seq[N] {
+	return maps.Keys(g.Vertices)
+}
+
+func (g *WeightedGraphOf[N, W]) Neighbors(vertex N) iter.Seq[N] {
+	return maps.Keys(g.Vertices[vertex])
+}
+
+func (g *WeightedGraphOf[N, W]) WeightedNeighbors(vertex N) iter.Seq2[N, W] {
+	return maps.All(g.Vertices[vertex])
+}
+
+func (g *WeightedGraphOf[N, W]) Degree(vertex N) int {
+	return len(g.Vertices[vertex])
+}
+
+// AddVertex adds an isolated vertex; an existing vertex is left unchanged.
+func (g *WeightedGraphOf[N, W]) AddVertex(vertex N) {
+	if _, exists := g.Vertices[vertex]; !exists {
+		g.Vertices[vertex] = make(map[N]W)
+	}
+}
+
+// RemoveVertex removes vertex together with all incident edges.
+func (g *WeightedGraphOf[N, W]) RemoveVertex(vertex N) {
+	for neighbor := range g.Vertices[vertex] {
+		delete(g.Vertices[neighbor], vertex)
+	}
+	delete(g.Vertices, vertex)
+	if g.attrs != nil {
+		g.attrs.removeVertex(vertex)
+	}
+}
+
+func (g *WeightedGraphOf[N, W]) HasVertex(vertex N) bool {
+	_, exists := g.Vertices[vertex]
+	return exists
+}
+
+func (g *WeightedGraphOf[N, W]) VertexCount() int {
+	return len(g.Vertices)
+}
+
+func (g *WeightedGraphOf[N, W]) EdgeCount() int {
+	count := 0
+	for u, neighbors := range g.Vertices {
+		for v := range neighbors {
+			if u <= v {
+				count++
+			}
+		}
+	}
+	return count
+}
+
+// SortedVertices returns all vertices in ascending order.
+func (g *WeightedGraphOf[N, W]) SortedVertices() []N {
+	return slices.Sorted(maps.Keys(g.Vertices))
+}
+
+// Edges yields every edge once as a (u, v) pair with u <= v.
+func (g *WeightedGraphOf[N, W]) Edges() iter.Seq2[N, N] {
+	return func(yield func(N, N) bool) {
+		for e := range g.WeightedEdges() {
+			if !yield(e.U, e.V) {
+				return
+			}
+		}
+	}
+}
+
+// WeightedEdges yields every edge once with U <= V.
+// Unlike GetEdges it does not allocate a slice of all edges.
+func (g *WeightedGraphOf[N, W]) WeightedEdges() iter.Seq[WeightedEdgeOf[N, W]] {
+	return func(yield func(WeightedEdgeOf[N, W]) bool) {
+		for u, neighbors := range g.Vertices {
+			for v, weight := range neighbors {
+				if u <= v && !yield(WeightedEdgeOf[N, W]{u, v, weight}) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// IncidentEdges yields (vertex, u) for every edge between vertex and u.
+func (g *WeightedGraphOf[N, W]) IncidentEdges(vertex N) iter.Seq2[N, N] {
+	return func(yield func(N, N) bool) {
+		for v := range g.Vertices[vertex] {
+			if !yield(vertex, v) {
+				return
+			}
+		}
+	}
+}
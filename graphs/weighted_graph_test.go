@@ -2,6 +2,7 @@ package graphs
 
 import (
 	"maps"
+	"slices"
 	"testing"
 )
 
@@ -107,3 +108,49 @@ func TestGetEdgeWeightInWeightedGraph(t *testing.T) {
 		t.Errorf("Expected no edge between A and C")
 	}
 }
+
+func TestGenericWeightedGraph(t *testing.T) {
+	graph := NewWeightedGraphOf[int, float64]()
+	graph.AddEdge(1, 2, 0.5)
+	graph.AddEdge(3, 2, 1.25)
+
+	weight, exists := graph.GetEdgeWeight(2, 3)
+	if !exists || weight != 1.25 {
+		t.Errorf("Expected edge between 2 and 3 with weight 1.25, but got %v", weight)
+	}
+
+	edgesExpected := map[WeightedEdgeOf[int, float64]]struct{}{
+		{1, 2, 0.5}:  {},
+		{2, 3, 1.25}: {},
+	}
+	resultEdges := make(map[WeightedEdgeOf[int, float64]]struct{})
+	for _, e := range graph.GetEdges() {
+		resultEdges[e] = struct{}{}
+	}
+	if !maps.Equal(resultEdges, edgesExpected) {
+		t.Errorf("Expected edges %v, got %v", edgesExpected, resultEdges)
+	}
+}
+
+func TestVertexOperationsInWeightedGraph(t *testing.T) {
+	graph := NewWeightedGraph()
+	graph.AddVertex("D")
+	graph.AddEdge("A", "B", 1)
+	graph.AddEdge("B", "C", 2)
+	graph.AddEdge("A", "C", 3)
+
+	if graph.VertexCount() != 4 || graph.EdgeCount() != 3 {
+		t.Errorf("Expected 4 vertices and 3 edges, got %d and %d", graph.VertexCount(), graph.EdgeCount())
+	}
+
+	graph.RemoveVertex("A")
+	if graph.HasVertex("A") || graph.HasEdge("B", "A") {
+		t.Errorf("Expected vertex A and its edges to be removed")
+	}
+	if graph.EdgeCount() != 1 {
+		t.Errorf("Expected 1 edge, got %d", graph.EdgeCount())
+	}
+	if !slices.Equal(graph.SortedVertices(), []string{"B", "C", "D"}) {
+		t.Errorf("Expected vertices [B C D], got %v", graph.SortedVertices())
+	}
+}
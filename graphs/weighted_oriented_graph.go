@@ -1,40 +1,163 @@
 package graphs
 
-type WeightedOrientedGraph struct {
-	vertices map[string]map[string]int
+import (
+	"iter"
+	"maps"
+	"slices"
+)
+
+// WeightedOrientedGraphOf is a directed weighted graph with vertices of type N
+// and edge weights of type W.
+type WeightedOrientedGraphOf[N Vertex, W Weight] struct {
+	vertices map[N]map[N]W
+	attrs    *AttributesOf[N]
 }
 
+// WeightedOrientedGraph is the string-vertex, int-weight directed graph.
+type WeightedOrientedGraph = WeightedOrientedGraphOf[string, int]
+
 func NewWeightedOrientedGraph() *WeightedOrientedGraph {
-	return &WeightedOrientedGraph{
-		vertices: make(map[string]map[string]int),
+	return NewWeightedOrientedGraphOf[string, int]()
+}
+
+func NewWeightedOrientedGraphOf[N Vertex, W Weight]() *WeightedOrientedGraphOf[N, W] {
+	return &WeightedOrientedGraphOf[N, W]{
+		vertices: make(map[N]map[N]W),
 	}
 }
 
-func (g *WeightedOrientedGraph) AddEdge(vertex1, vertex2 string, weight int) {
+func (g *WeightedOrientedGraphOf[N, W]) AddEdge(vertex1, vertex2 N, weight W) {
 	if _, exists := g.vertices[vertex1]; !exists {
-		g.vertices[vertex1] = make(map[string]int)
+		g.vertices[vertex1] = make(map[N]W)
 	}
 	if _, exists := g.vertices[vertex2]; !exists {
-		g.vertices[vertex2] = make(map[string]int)
+		g.vertices[vertex2] = make(map[N]W)
 	}
 
 	g.vertices[vertex1][vertex2] = weight
 }
 
-func (g *WeightedOrientedGraph) RemoveEdge(vertex1, vertex2 string) {
+func (g *WeightedOrientedGraphOf[N, W]) RemoveEdge(vertex1, vertex2 N) {
 	delete(g.vertices[vertex1], vertex2)
+	if g.attrs != nil {
+		g.attrs.removeEdge(vertex1, vertex2)
+	}
+}
+
+// Attrs returns the vertex and edge attributes of the graph.
+func (g *WeightedOrientedGraphOf[N, W]) Attrs() *AttributesOf[N] {
+	return lazyAttrs(&g.attrs, true)
+}
+
+func (g *WeightedOrientedGraphOf[N, W]) storedAttrs() *AttributesOf[N] {
+	return g.attrs
 }
 
-func (g *WeightedOrientedGraph) GetNeighbors(vertex string) map[string]int {
+func (g *WeightedOrientedGraphOf[N, W]) GetNeighbors(vertex N) map[N]W {
 	return g.vertices[vertex]
 }
 
-func (g *WeightedOrientedGraph) HasEdge(vertex1, vertex2 string) bool {
+func (g *WeightedOrientedGraphOf[N, W]) HasEdge(vertex1, vertex2 N) bool {
 	_, exists := g.vertices[vertex1][vertex2]
 	return exists
 }
 
-func (g *WeightedOrientedGraph) GetEdgeWeight(vertex1, vertex2 string) (int, bool) {
+func (g *WeightedOrientedGraphOf[N, W]) GetEdgeWeight(vertex1, vertex2 N) (W, bool) {
 	weight, exists := g.vertices[vertex1][vertex2]
 	return weight, exists
 }
+
+func (g *WeightedOrientedGraphOf[N, W]) Directed() bool {
+	return true
+}
+
+func (g *WeightedOrientedGraphOf[N, W]) AllVertices() iter.Seq[N] {
+	return maps.Keys(g.vertices)
+}
+
+func (g *WeightedOrientedGraphOf[N, W]) Neighbors(vertex N) iter.Seq[N] {
+	return maps.Keys(g.vertices[vertex])
+}
+
+func (g *WeightedOrientedGraphOf[N, W]) WeightedNeighbors(vertex N) iter.Seq2[N, W] {
+	return maps.All(g.vertices[vertex])
+}
+
+func (g *WeightedOrientedGraphOf[N, W]) Degree(vertex N) int {
+	return len(g.vertices[vertex])
+}
+
+// AddVertex adds an isolated vertex; an existing vertex is left unchanged.
+func (g *WeightedOrientedGraphOf[N, W]) AddVertex(vertex N) {
+	if _, exists := g.vertices[vertex]; !exists {
+		g.vertices[vertex] = make(map[N]W)
+	}
+}
+
+// RemoveVertex removes vertex together with all incoming and outgoing edges.
+func (g *WeightedOrientedGraphOf[N, W]) RemoveVertex(vertex N) {
+	delete(g.vertices, vertex)
+	for _, neighbors := range g.vertices {
+		delete(neighbors, vertex)
+	}
+	if g.attrs != nil {
+		g.attrs.removeVertex(vertex)
+	}
+}
+
+func (g *WeightedOrientedGraphOf[N, W]) HasVertex(vertex N) bool {
+	_, exists := g.vertices[vertex]
+	return exists
+}
+
+func (g *WeightedOrientedGraphOf[N, W]) VertexCount() int {
+	return len(g.vertices)
+}
+
+func (g *WeightedOrientedGraphOf[N, W]) EdgeCount() int {
+	count := 0
+	for _, neighbors := range g.vertices {
+		count += len(neighbors)
+	}
+	return count
+}
+
+// SortedVertices returns all vertices in ascending order.
+func (g *WeightedOrientedGraphOf[N, W]) SortedVertices() []N {
+	return slices.Sorted(maps.Keys(g.vertices))
+}
+
+// Edges yields every edge as a (from, to) pair.
+func (g *WeightedOrientedGraphOf[N, W]) Edges() iter.Seq2[N, N] {
+	return func(yield func(N, N) bool) {
+		for e := range g.WeightedEdges() {
+			if !yield(e.U, e.V) {
+				return
+			}
+		}
+	}
+}
+
+// WeightedEdges yields every edge with U as its tail and V as its head.
+func (g *WeightedOrientedGraphOf[N, W]) WeightedEdges() iter.Seq[WeightedEdgeOf[N, W]] {
+	return func(yield func(WeightedEdgeOf[N, W]) bool) {
+		for u, neighbors := range g.vertices {
+			for v, weight := range neighbors {
+				if !yield(WeightedEdgeOf[N, W]{u, v, weight}) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// IncidentEdges yields (vertex, u) for every edge from vertex to u.
+func (g *WeightedOrientedGraphOf[N, W]) IncidentEdges(vertex N) iter.Seq2[N, N] {
+	return func(yield func(N, N) bool) {
+		for v := range g.vertices[vertex] {
+			if !yield(vertex, v) {
+				return
+			}
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package graphs
 
 import (
+	"slices"
 	"testing"
 )
 
@@ -89,3 +90,35 @@ func TestGetEdgeWeightInWeightedOrientedGraph(t *testing.T) {
 		t.Errorf("Expected no edge from B to A")
 	}
 }
+
+func TestGenericWeightedOrientedGraph(t *testing.T) {
+	graph := NewWeightedOrientedGraphOf[int, float32]()
+	graph.AddEdge(1, 2, 2.5)
+
+	weight, exists := graph.GetEdgeWeight(1, 2)
+	if !exists || weight != 2.5 {
+		t.Errorf("Expected edge from 1 to 2 with weight 2.5, but got %v", weight)
+	}
+	if graph.HasEdge(2, 1) {
+		t.Errorf("Expected no edge from 2 to 1")
+	}
+}
+
+func TestVertexOperationsInWeightedOrientedGraph(t *testing.T) {
+	graph := NewWeightedOrientedGraph()
+	graph.AddEdge("A", "B", 1)
+	graph.AddEdge("C", "A", 2)
+	graph.AddVertex("D")
+
+	if graph.VertexCount() != 4 || graph.EdgeCount() != 2 {
+		t.Errorf("Expected 4 vertices and 2 edges, got %d and %d", graph.VertexCount(), graph.EdgeCount())
+	}
+
+	graph.RemoveVertex("A")
+	if graph.HasEdge("C", "A") {
+		t.Errorf("Expected incoming edge C -> A to be removed")
+	}
+	if !slices.Equal(graph.SortedVertices(), []string{"B", "C", "D"}) {
+		t.Errorf("Expected vertices [B C D], got %v", graph.SortedVertices())
+	}
+}
@@ -1,5 +1,87 @@
 package mst
 
-import "github.com/Salvatore112/graph_analysis_algorithms/graphs"
+import (
+	"cmp"
 
-type MSTAlogorithm func(g *graphs.WeightedGraph) *graphs.WeightedGraph
+	"github.com/Salvatore112/graph_analysis_algorithms/graphs"
+)
+
+// MSTAlogorithm builds a minimum spanning tree of an undirected weighted graph.
+// For a disconnected graph it builds a minimum spanning forest: a minimum
+// spanning tree of every connected component.
+//
+// All algorithms convert their input with graphs.ToCSR and work on vertex
+// indices. ToCSR returns a *graphs.CSRGraph as it is, so passing one skips
+// the conversion, with its sort of the vertex names, and any per-edge map
+// lookups.
+//
+// Ties between equal weights are broken by the endpoint indices (see
+// compareCSREdges), which makes the minimum spanning tree unique: every
+// algorithm returns the same tree for the same input.
+//
+// KruskalMSTOf, PrimMSTOf and BoruvkaMSTOf take weights of any type, e.g.
+// a graphs.WeightedGraphOf[string, float64].
+type MSTAlogorithm func(g graphs.Weighted) *MSTResult
+
+// csrEdgeOf is an undirected edge between two CSR vertex indices.
+type csrEdgeOf[W graphs.Weight] struct {
+	u, v   int32
+	weight W
+}
+
+// csrEdge is the int-weight edge used by the analyses built on Kruskal.
+type csrEdge = csrEdgeOf[int]
+
+// compareCSREdges orders edges by weight, then by u, then by v.
+//
+// ToCSR numbers vertices in sorted name order, so for its graphs this is
+// the canonical order of graphs.CompareWeightedEdges.
+func compareCSREdges[W graphs.Weight](a, b csrEdgeOf[W]) int {
+	if c := cmp.Compare(a.weight, b.weight); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(a.u, b.u); c != 0 {
+		return c
+	}
+	return cmp.Compare(a.v, b.v)
+}
+
+// csrEdges lists every edge of an undirected CSR graph once, with u < v.
+// Self-loops never belong to a spanning tree and are skipped.
+func csrEdges[W graphs.Weight](g *graphs.CSRGraphOf[W]) []csrEdgeOf[W] {
+	edges := make([]csrEdgeOf[W], 0, g.ArcCount()/2)
+	for u := range int32(g.VertexCount()) {
+		lo, hi := g.ArcRange(u)
+		for i := lo; i < hi; i++ {
+			if v := g.Target(i); u < v {
+				edges = append(edges, csrEdgeOf[W]{u, v, g.ArcWeight(i)})
+			}
+		}
+	}
+	return edges
+}
+
+// csrComponents returns the vertices of every connected component of an
+// undirected CSR graph, components ordered by their smallest vertex.
+func csrComponents[W graphs.Weight](g *graphs.CSRGraphOf[W]) [][]int32 {
+	seen := make([]bool, g.VertexCount())
+	components := make([][]int32, 0)
+	for root := range int32(g.VertexCount()) {
+		if seen[root] {
+			continue
+		}
+		seen[root] = true
+		component := []int32{root}
+		for i := 0; i < len(component); i++ {
+			lo, hi := g.ArcRange(component[i])
+			for j := lo; j < hi; j++ {
+				if v := g.Target(j); !seen[v] {
+					seen[v] = true
+					component = append(component, v)
+				}
+			}
+		}
+		components = append(components, component)
+	}
+	return components
+}
@@ -6,66 +6,67 @@ import (
 
 const NO_CC = -1
 
-func BoruvkaMST(g *graphs.WeightedGraph) (mst *graphs.WeightedGraph) {
-	mst = graphs.NewWeightedGraph()
-	vertexToID := make(map[string]int)
-	id := 0
-	for v := range g.Vertices {
-		vertexToID[v] = id
-		id++
-	}
+// BoruvkaMST adds the cheapest edge leaving every component in rounds until
+// no component has one. Its Iterations count the rounds, including the
+// last one that finds nothing to add.
+func BoruvkaMST(g graphs.Weighted) *MSTResult {
+	return BoruvkaMSTOf(g)
+}
 
-	dsu := NewDSU(len(g.Vertices))
-	edgesSet := make(map[graphs.WeightedEdge]struct{})
-	for _, edge := range g.GetEdges() {
-		edgesSet[edge] = struct{}{}
-	}
+// BoruvkaMSTOf is BoruvkaMST for weights of any type.
+func BoruvkaMSTOf[W graphs.Weight](g graphs.WeightedOf[W]) *MSTResultOf[W] {
+	csr := graphs.ToCSROf[W](g)
+	tree, rounds := boruvkaCSR(csr)
+	return newResult(csr, tree, rounds)
+}
+
+func boruvkaCSR[W graphs.Weight](g *graphs.CSRGraphOf[W]) ([]csrEdgeOf[W], int) {
+	n := g.VertexCount()
+	dsu := NewDSU(n)
+	edges := csrEdges(g)
+	tree := make([]csrEdgeOf[W], 0, n)
+	cheapest := make([]int, n)
+	rounds := 0
 	for {
-		cheapest := make(map[int]graphs.WeightedEdge)
-		for edge := range edgesSet {
-			u := edge.U
-			v := edge.V
-			weight := edge.Weight
-			rootU := dsu.Find(vertexToID[u])
-			rootV := dsu.Find(vertexToID[v])
+		rounds++
+		for i := range cheapest {
+			cheapest[i] = NO_CC
+		}
+		for i, edge := range edges {
+			rootU := dsu.Find(int(edge.u))
+			rootV := dsu.Find(int(edge.v))
 			if rootU != rootV {
-				if chepeastEdge, exist := cheapest[rootU]; !exist || weight < chepeastEdge.Weight {
-					cheapest[rootU] = edge
+				if c := cheapest[rootU]; c == NO_CC || compareCSREdges(edge, edges[c]) < 0 {
+					cheapest[rootU] = i
 				}
-				if chepeastEdge, exist := cheapest[rootV]; !exist || weight < chepeastEdge.Weight {
-					cheapest[rootV] = edge
+				if c := cheapest[rootV]; c == NO_CC || compareCSREdges(edge, edges[c]) < 0 {
+					cheapest[rootV] = i
 				}
 			}
 		}
-		for _, edge := range cheapest {
-			u := edge.U
-			v := edge.V
-			uId := vertexToID[u]
-			vId := vertexToID[v]
-			weight := edge.Weight
-			rootU := dsu.Find(uId)
-			rootV := dsu.Find(vId)
-			if rootU != rootV {
-				mst.AddEdge(u, v, weight)
-				dsu.Union(uId, rootV)
+		merged := false
+		for _, c := range cheapest {
+			if c == NO_CC {
+				continue
 			}
-			delete(edgesSet, edge)
-		}
-		for edge := range edgesSet {
-			u := edge.U
-			v := edge.V
-			uId := vertexToID[u]
-			vId := vertexToID[v]
-			rootU := dsu.Find(uId)
-			rootV := dsu.Find(vId)
-			if rootU == rootV {
-				delete(edgesSet, edge)
+			edge := edges[c]
+			if dsu.Find(int(edge.u)) != dsu.Find(int(edge.v)) {
+				tree = append(tree, edge)
+				dsu.Union(int(edge.u), int(edge.v))
+				merged = true
 			}
 		}
-
-		if len(cheapest) == 0 {
+		if !merged {
 			break
 		}
+
+		remaining := edges[:0]
+		for _, edge := range edges {
+			if dsu.Find(int(edge.u)) != dsu.Find(int(edge.v)) {
+				remaining = append(remaining, edge)
+			}
+		}
+		edges = remaining
 	}
-	return mst
+	return tree, rounds
 }
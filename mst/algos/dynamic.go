@@ -0,0 +1,220 @@
+package mst
+
+import (
+	"slices"
+
+	"github.com/Salvatore112/graph_analysis_algorithms/graphs"
+)
+
+// DynamicMST maintains the minimum spanning forest of an undirected weighted
+// graph under edge insertions, deletions and weight changes.
+//
+// The forest is kept in a link-cut tree where every tree edge is a node of
+// its own that carries the edge, so the heaviest edge of a tree path takes
+// O(log V). Inserting an edge that closes a cycle replaces the heaviest
+// edge of that cycle if the new edge is lighter. Deleting a tree edge scans
+// the non-tree edges in increasing order for the first one that reconnects
+// the two halves, which costs O(k log V) for k edges scanned.
+//
+// Edges are compared like in graphs.CompareWeightedEdges, so the forest is
+// always the one KruskalMST returns for the same graph.
+type DynamicMST struct {
+	lct    linkCut
+	vertex map[string]int32
+	// weights holds every edge of the graph, keyed by its endpoints with
+	// the smaller one first.
+	weights map[[2]string]int
+	// treeNode holds the link-cut node of every tree edge, and edgeOf the
+	// edge of every edge node.
+	treeNode map[[2]string]int32
+	edgeOf   map[int32]graphs.WeightedEdge
+	free     []int32
+	// nonTree holds the other edges except self-loops in canonical order.
+	nonTree []graphs.WeightedEdge
+	total   int
+}
+
+// NewDynamicMST builds the minimum spanning forest of g and prepares it
+// for updates. Later changes to g are not tracked.
+func NewDynamicMST(g graphs.Weighted) *DynamicMST {
+	d := &DynamicMST{
+		vertex:   make(map[string]int32),
+		weights:  make(map[[2]string]int),
+		treeNode: make(map[[2]string]int32),
+		edgeOf:   make(map[int32]graphs.WeightedEdge),
+		nonTree:  make([]graphs.WeightedEdge, 0),
+	}
+	d.lct.greater = func(a, b int32) bool {
+		return graphs.CompareWeightedEdges(d.edgeOf[a], d.edgeOf[b]) > 0
+	}
+
+	res := KruskalMST(g)
+	for v := range res.Tree.AllVertices() {
+		d.AddVertex(v)
+	}
+	for _, e := range res.Edges {
+		d.weights[[2]string{e.U, e.V}] = e.Weight
+		d.link(e)
+	}
+	for e := range g.WeightedEdges() {
+		e = canonical(e.U, e.V, e.Weight)
+		if _, exists := d.weights[[2]string{e.U, e.V}]; exists {
+			continue
+		}
+		d.weights[[2]string{e.U, e.V}] = e.Weight
+		if e.U != e.V {
+			d.addNonTree(e)
+		}
+	}
+	return d
+}
+
+func canonical(u, v string, weight int) graphs.WeightedEdge {
+	if u > v {
+		u, v = v, u
+	}
+	return graphs.WeightedEdge{U: u, V: v, Weight: weight}
+}
+
+// AddVertex adds an isolated vertex; existing vertices are left alone.
+func (d *DynamicMST) AddVertex(v string) {
+	if _, exists := d.vertex[v]; !exists {
+		d.vertex[v] = d.lct.add()
+	}
+}
+
+// InsertEdge adds the edge u-v with the given weight, or changes its
+// weight if it exists, and updates the forest.
+func (d *DynamicMST) InsertEdge(u, v string, weight int) {
+	e := canonical(u, v, weight)
+	key := [2]string{e.U, e.V}
+	if _, exists := d.weights[key]; exists {
+		d.DeleteEdge(u, v)
+	}
+	d.AddVertex(u)
+	d.AddVertex(v)
+	d.weights[key] = weight
+	if u == v {
+		return
+	}
+
+	x, y := d.vertex[e.U], d.vertex[e.V]
+	if !d.lct.connected(x, y) {
+		d.link(e)
+		return
+	}
+	heaviest := d.edgeOf[d.lct.pathMax(x, y)]
+	if graphs.CompareWeightedEdges(e, heaviest) >= 0 {
+		d.addNonTree(e)
+		return
+	}
+	d.cut(heaviest)
+	d.addNonTree(heaviest)
+	d.link(e)
+}
+
+// DeleteEdge removes the edge u-v and updates the forest. It reports
+// whether the edge existed.
+func (d *DynamicMST) DeleteEdge(u, v string) bool {
+	e := canonical(u, v, 0)
+	key := [2]string{e.U, e.V}
+	weight, exists := d.weights[key]
+	if !exists {
+		return false
+	}
+	delete(d.weights, key)
+	e.Weight = weight
+	if u == v {
+		return true
+	}
+	if _, inTree := d.treeNode[key]; !inTree {
+		d.removeNonTree(e)
+		return true
+	}
+
+	d.cut(e)
+	for i, r := range d.nonTree {
+		if !d.lct.connected(d.vertex[r.U], d.vertex[r.V]) {
+			d.nonTree = slices.Delete(d.nonTree, i, i+1)
+			d.link(r)
+			break
+		}
+	}
+	return true
+}
+
+// WeightChange sets the weight of the existing edge u-v and updates the
+// forest. It reports whether the edge existed.
+func (d *DynamicMST) WeightChange(u, v string, weight int) bool {
+	if _, exists := d.weights[[2]string{min(u, v), max(u, v)}]; !exists {
+		return false
+	}
+	d.InsertEdge(u, v, weight)
+	return true
+}
+
+// TotalWeight returns the weight of the current minimum spanning forest.
+func (d *DynamicMST) TotalWeight() int {
+	return d.total
+}
+
+// Edges returns the edges of the current forest in canonical order.
+func (d *DynamicMST) Edges() []graphs.WeightedEdge {
+	res := make([]graphs.WeightedEdge, 0, len(d.treeNode))
+	for _, node := range d.treeNode {
+		res = append(res, d.edgeOf[node])
+	}
+	graphs.SortWeightedEdges(res)
+	return res
+}
+
+// Tree returns the current forest as a graph with all vertices.
+func (d *DynamicMST) Tree() *graphs.WeightedGraph {
+	tree := graphs.NewWeightedGraph()
+	for v := range d.vertex {
+		tree.AddVertex(v)
+	}
+	for _, e := range d.Edges() {
+		tree.AddEdge(e.U, e.V, e.Weight)
+	}
+	return tree
+}
+
+// link adds e, whose endpoints are in different trees, to the forest.
+func (d *DynamicMST) link(e graphs.WeightedEdge) {
+	var node int32
+	if n := len(d.free); n > 0 {
+		node, d.free = d.free[n-1], d.free[:n-1]
+	} else {
+		node = d.lct.add()
+	}
+	d.edgeOf[node] = e
+	d.lct.reset(node, true)
+	d.lct.link(d.vertex[e.U], node)
+	d.lct.link(node, d.vertex[e.V])
+	d.treeNode[[2]string{e.U, e.V}] = node
+	d.total += e.Weight
+}
+
+// cut removes the tree edge e from the forest.
+func (d *DynamicMST) cut(e graphs.WeightedEdge) {
+	key := [2]string{e.U, e.V}
+	node := d.treeNode[key]
+	d.lct.cut(d.vertex[e.U], node)
+	d.lct.cut(node, d.vertex[e.V])
+	delete(d.treeNode, key)
+	delete(d.edgeOf, node)
+	d.free = append(d.free, node)
+	d.total -= e.Weight
+}
+
+func (d *DynamicMST) addNonTree(e graphs.WeightedEdge) {
+	i, _ := slices.BinarySearchFunc(d.nonTree, e, graphs.CompareWeightedEdges)
+	d.nonTree = slices.Insert(d.nonTree, i, e)
+}
+
+func (d *DynamicMST) removeNonTree(e graphs.WeightedEdge) {
+	if i, found := slices.BinarySearchFunc(d.nonTree, e, graphs.CompareWeightedEdges); found {
+		d.nonTree = slices.Delete(d.nonTree, i, i+1)
+	}
+}
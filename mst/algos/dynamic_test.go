@@ -0,0 +1,78 @@
+package mst
+
+import (
+	"math/rand/v2"
+	"strconv"
+	"testing"
+
+	"github.com/Salvatore112/graph_analysis_algorithms/graphs"
+	"github.com/Salvatore112/graph_analysis_algorithms/graphs/generators"
+)
+
+func checkDynamic(t *testing.T, d *DynamicMST, graph *graphs.WeightedGraph, step string) {
+	t.Helper()
+	expected := KruskalMST(graph)
+	if d.TotalWeight() != expected.TotalWeight {
+		t.Fatalf("%s: expected weight %d, got %d", step, expected.TotalWeight, d.TotalWeight())
+	}
+	if tree := d.Tree(); !tree.Equal(expected.Tree) {
+		t.Fatalf("%s: expected the Kruskal tree, diff %v", step, tree.Diff(expected.Tree))
+	}
+}
+
+func TestDynamicMST(t *testing.T) {
+	d := NewDynamicMST(graphOf(edges))
+	graph := graphOf(edges)
+	checkDynamic(t, d, graph, "init")
+
+	d.InsertEdge("A", "F", 1)
+	graph.AddEdge("A", "F", 1)
+	checkDynamic(t, d, graph, "insert A-F")
+
+	if !d.WeightChange("F", "A", 20) {
+		t.Fatalf("Expected A-F to exist")
+	}
+	graph.AddEdge("A", "F", 20)
+	checkDynamic(t, d, graph, "change A-F")
+
+	for _, e := range KruskalMST(graph).Edges {
+		d.DeleteEdge(e.V, e.U)
+		graph.RemoveEdge(e.U, e.V)
+		checkDynamic(t, d, graph, "delete "+e.U+"-"+e.V)
+	}
+	if d.DeleteEdge("A", "Z") || d.WeightChange("A", "Z", 1) {
+		t.Fatalf("Expected A-Z to be missing")
+	}
+}
+
+func TestDynamicMSTRandomOperations(t *testing.T) {
+	const n = 30
+	for seed := range uint64(5) {
+		graph := generators.GNM(n, 60, seed).Weighted(generators.UniformWeights(1, 10), seed)
+		d := NewDynamicMST(graph)
+		checkDynamic(t, d, graph, "init")
+
+		r := rand.New(rand.NewPCG(seed, seed))
+		for step := range 500 {
+			// A few extra vertices start out isolated.
+			u, v := strconv.Itoa(r.IntN(n+3)), strconv.Itoa(r.IntN(n+3))
+			w := r.IntN(10) + 1
+			var op string
+			switch _, exists := graph.GetEdgeWeight(u, v); {
+			case exists && r.IntN(2) == 0:
+				op = "delete"
+				d.DeleteEdge(u, v)
+				graph.RemoveEdge(u, v)
+			case exists:
+				op = "change"
+				d.WeightChange(u, v, w)
+				graph.AddEdge(u, v, w)
+			default:
+				op = "insert"
+				d.InsertEdge(u, v, w)
+				graph.AddEdge(u, v, w)
+			}
+			checkDynamic(t, d, graph, "seed "+strconv.FormatUint(seed, 10)+" step "+strconv.Itoa(step)+" "+op+" "+u+"-"+v)
+		}
+	}
+}
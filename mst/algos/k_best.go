@@ -0,0 +1,143 @@
+package mst
+
+import (
+	"cmp"
+	"iter"
+
+	"github.com/Salvatore112/graph_analysis_algorithms/graphs"
+)
+
+// SpanningTrees yields the spanning forests of g in nondecreasing order of
+// total weight, starting with the minimum spanning forest. Every forest is
+// yielded once; stop the iteration to get the k best ones.
+//
+// The enumeration partitions the space of forests (Lawler's scheme as used
+// by Gabow and by Katoh, Ibaraki and Mine): after a forest with edges
+// e1..ek is yielded, the remaining forests that agree with its constraints
+// are split into k groups, the i-th one including e1..e(i-1) and excluding
+// ei. The best forest of every group comes from a Kruskal run that honors
+// the constraints, so each step costs O(V·E α(V)) on top of the heap.
+func SpanningTrees(g graphs.Weighted) iter.Seq[*MSTResult] {
+	return func(yield func(*MSTResult) bool) {
+		csr := graphs.ToCSR(g)
+		edges := getSortedEdges(csr)
+		size := csr.VertexCount() - len(csrComponents(csr))
+
+		type subproblem struct {
+			include, exclude []int
+			tree             []int
+			weight           int
+			examined         int
+		}
+		solve := func(include, exclude []int) (*subproblem, bool) {
+			tree, examined := constrainedKruskal(csr.VertexCount(), edges, include, exclude)
+			if len(tree) != size {
+				return nil, false
+			}
+			p := &subproblem{include: include, exclude: exclude, tree: tree, examined: examined}
+			for _, i := range tree {
+				p.weight += edges[i].weight
+			}
+			return p, true
+		}
+
+		// Subproblems with equal weights leave the queue in creation order.
+		type priority struct{ weight, id int }
+		q := NewPQFunc[int](func(a, b priority) bool {
+			return cmp.Or(cmp.Compare(a.weight, b.weight), cmp.Compare(a.id, b.id)) < 0
+		})
+		problems := make(map[int]*subproblem)
+		nextID := 0
+		push := func(p *subproblem) {
+			problems[nextID] = p
+			q.Push(Node[int, priority]{nextID, priority{p.weight, nextID}})
+			nextID++
+		}
+
+		first, ok := solve(nil, nil)
+		if !ok {
+			return
+		}
+		push(first)
+		for !q.IsEmpty() {
+			id := q.Pop().(Node[int, priority]).Value
+			p := problems[id]
+			delete(problems, id)
+
+			tree := make([]csrEdge, len(p.tree))
+			for i, e := range p.tree {
+				tree[i] = edges[e]
+			}
+			if !yield(newResult(csr, tree, p.examined)) {
+				return
+			}
+
+			included := make(map[int]bool, len(p.include))
+			for _, e := range p.include {
+				included[e] = true
+			}
+			include := append([]int(nil), p.include...)
+			for _, e := range p.tree {
+				if included[e] {
+					continue
+				}
+				exclude := append(append([]int(nil), p.exclude...), e)
+				if child, ok := solve(append([]int(nil), include...), exclude); ok {
+					push(child)
+				}
+				include = append(include, e)
+			}
+		}
+	}
+}
+
+// KBestSpanningTrees returns the k spanning forests of g with the smallest
+// total weights in nondecreasing order, or all of them if there are fewer.
+func KBestSpanningTrees(g graphs.Weighted, k int) []*MSTResult {
+	res := make([]*MSTResult, 0, k)
+	if k <= 0 {
+		return res
+	}
+	for tree := range SpanningTrees(g) {
+		res = append(res, tree)
+		if len(res) == k {
+			break
+		}
+	}
+	return res
+}
+
+// constrainedKruskal builds the minimum spanning forest that contains the
+// edges include and avoids the edges exclude, given as indices into the
+// sorted edges. It returns the chosen edge indices, included ones first,
+// and the number of edges examined. If the included edges form a cycle
+// the forest comes out short.
+func constrainedKruskal(n int, edges []csrEdge, include, exclude []int) ([]int, int) {
+	dsu := NewDSU(n)
+	skip := make(map[int]bool, len(include)+len(exclude))
+	tree := make([]int, 0, n)
+	for _, i := range include {
+		skip[i] = true
+		u, v := int(edges[i].u), int(edges[i].v)
+		if dsu.Find(u) != dsu.Find(v) {
+			dsu.Union(u, v)
+			tree = append(tree, i)
+		}
+	}
+	for _, i := range exclude {
+		skip[i] = true
+	}
+	examined := 0
+	for i, e := range edges {
+		if skip[i] {
+			continue
+		}
+		examined++
+		u, v := int(e.u), int(e.v)
+		if dsu.Find(u) != dsu.Find(v) {
+			dsu.Union(u, v)
+			tree = append(tree, i)
+		}
+	}
+	return tree, examined
+}
@@ -0,0 +1,160 @@
+package mst
+
+import (
+	"math"
+	"slices"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/Salvatore112/graph_analysis_algorithms/graphs"
+	"github.com/Salvatore112/graph_analysis_algorithms/graphs/generators"
+)
+
+// allSpanningTreeWeights returns the sorted weights of all spanning trees
+// of a connected graph by checking every subset of V-1 edges.
+func allSpanningTreeWeights(g *graphs.WeightedGraph) []int {
+	edges := g.SortedEdges()
+	vertices := g.SortedVertices()
+	index := make(map[string]int)
+	for i, v := range vertices {
+		index[v] = i
+	}
+	weights := make([]int, 0)
+	for mask := 0; mask < 1<<len(edges); mask++ {
+		dsu := NewDSU(len(vertices))
+		count, weight, acyclic := 0, 0, true
+		for i, e := range edges {
+			if mask&(1<<i) == 0 {
+				continue
+			}
+			u, v := index[e.U], index[e.V]
+			if dsu.Find(u) == dsu.Find(v) {
+				acyclic = false
+				break
+			}
+			dsu.Union(u, v)
+			count++
+			weight += e.Weight
+		}
+		if acyclic && count == len(vertices)-1 {
+			weights = append(weights, weight)
+		}
+	}
+	sort.Ints(weights)
+	return weights
+}
+
+func treeKey(res *MSTResult) string {
+	keys := make([]string, 0, len(res.Edges))
+	for _, e := range res.Edges {
+		keys = append(keys, e.U+"-"+e.V)
+	}
+	slices.Sort(keys)
+	return strings.Join(keys, ",")
+}
+
+func TestSpanningTreesEnumeratesAll(t *testing.T) {
+	for seed := range uint64(3) {
+		graph := generators.Complete(5).Weighted(generators.UniformWeights(1, 4), seed)
+		expected := allSpanningTreeWeights(graph)
+		if len(expected) != 125 {
+			t.Fatalf("Cayley's formula gives 125 trees of K5, brute force found %d", len(expected))
+		}
+
+		weights := make([]int, 0)
+		seen := make(map[string]bool)
+		for tree := range SpanningTrees(graph) {
+			if key := treeKey(tree); seen[key] {
+				t.Fatalf("Tree %s yielded twice", key)
+			} else {
+				seen[key] = true
+			}
+			if len(tree.Edges) != 4 || len(tree.Components) != 1 {
+				t.Fatalf("Expected a spanning tree, got %v", tree.Edges)
+			}
+			weights = append(weights, tree.TotalWeight)
+		}
+		if !slices.Equal(weights, expected) {
+			t.Errorf("seed %d: expected weights %v, got %v", seed, expected, weights)
+		}
+	}
+}
+
+func TestKBestSpanningTrees(t *testing.T) {
+	graph := graphOf(edges)
+	best := KBestSpanningTrees(graph, 5)
+	if len(best) != 5 {
+		t.Fatalf("Expected 5 trees, got %d", len(best))
+	}
+	if !best[0].Tree.Equal(KruskalMST(graph).Tree) {
+		t.Errorf("The first tree must be the minimum spanning tree")
+	}
+	expected := allSpanningTreeWeights(graph)[:5]
+	for i, tree := range best {
+		if tree.TotalWeight != expected[i] {
+			t.Errorf("Tree %d: expected weight %d, got %d", i, expected[i], tree.TotalWeight)
+		}
+	}
+
+	path := graphOf([]graphs.WeightedEdge{{U: "A", V: "B", Weight: 1}, {U: "B", V: "C", Weight: 2}})
+	if trees := KBestSpanningTrees(path, 3); len(trees) != 1 {
+		t.Errorf("A tree has only itself as spanning tree, got %d", len(trees))
+	}
+	if trees := KBestSpanningTrees(path, 0); len(trees) != 0 {
+		t.Errorf("Expected no trees for k = 0, got %d", len(trees))
+	}
+}
+
+func TestSpanningForestsOfDisconnectedGraph(t *testing.T) {
+	// Two triangles have 3 * 3 spanning forests.
+	graph := graphOf([]graphs.WeightedEdge{
+		{U: "A", V: "B", Weight: 1}, {U: "B", V: "C", Weight: 2}, {U: "C", V: "A", Weight: 3},
+		{U: "D", V: "E", Weight: 1}, {U: "E", V: "F", Weight: 1}, {U: "F", V: "D", Weight: 5},
+	})
+	weights := make([]int, 0)
+	for forest := range SpanningTrees(graph) {
+		if len(forest.Edges) != 4 || len(forest.Components) != 2 {
+			t.Fatalf("Expected a spanning forest, got %v", forest.Edges)
+		}
+		weights = append(weights, forest.TotalWeight)
+	}
+	expected := []int{5, 6, 7, 9, 9, 10, 10, 11, 11}
+	if !slices.Equal(weights, expected) {
+		t.Errorf("Expected weights %v, got %v", expected, weights)
+	}
+}
+
+func TestMaximumSpanningTree(t *testing.T) {
+	graph := graphOf(edges)
+	all := allSpanningTreeWeights(graph)
+	forEachAlgorithm(t, func(t *testing.T, mstAlgorithm MSTAlogorithm) {
+		res := MaximumSpanningTree(graph, mstAlgorithm)
+		if res.TotalWeight != all[len(all)-1] || len(res.Edges) != 6 {
+			t.Errorf("Expected weight %d, got %d", all[len(all)-1], res.TotalWeight)
+		}
+		for _, e := range res.Edges {
+			if w, _ := graph.GetEdgeWeight(e.U, e.V); w != e.Weight || e.Weight <= 0 {
+				t.Errorf("Edge %v must keep its weight %d", e, w)
+			}
+		}
+		if !res.Tree.Equal(MaximumSpanningTree(graph, KruskalMST).Tree) {
+			t.Errorf("Differs from Kruskal")
+		}
+	})
+}
+
+func TestMaximumSpanningTreeWithMinInt(t *testing.T) {
+	graph := graphs.NewWeightedGraph()
+	graph.AddEdge("A", "B", math.MinInt)
+	graph.AddEdge("B", "C", 0)
+	graph.AddEdge("A", "C", 5)
+	graph.AddEdge("C", "D", math.MinInt)
+
+	forEachAlgorithm(t, func(t *testing.T, mstAlgorithm MSTAlogorithm) {
+		res := MaximumSpanningTree(graph, mstAlgorithm)
+		if res.TotalWeight != 5+math.MinInt || res.Tree.HasEdge("A", "B") || !res.Tree.HasEdge("C", "D") {
+			t.Errorf("Expected the edges A-C, B-C and C-D, got %v", res.Edges)
+		}
+	})
+}
@@ -1,48 +1,49 @@
 package mst
 
 import (
-	"sort"
+	"slices"
 
 	"github.com/Salvatore112/graph_analysis_algorithms/graphs"
 )
 
-func KruskalMST(g *graphs.WeightedGraph) (mst *graphs.WeightedGraph) {
-	mst = graphs.NewWeightedGraph()
-	vertexToID := make(map[string]int)
-	id := 0
-	for v := range g.Vertices {
-		vertexToID[v] = id
-		id++
-	}
-	dsu := NewDSU(len(g.Vertices))
+// KruskalMST adds edges in increasing order unless they close a cycle. Its
+// Iterations count the edges examined.
+func KruskalMST(g graphs.Weighted) *MSTResult {
+	return KruskalMSTOf(g)
+}
+
+// KruskalMSTOf is KruskalMST for weights of any type.
+func KruskalMSTOf[W graphs.Weight](g graphs.WeightedOf[W]) *MSTResultOf[W] {
+	csr := graphs.ToCSROf[W](g)
+	tree, iterations := kruskalCSR(csr)
+	return newResult(csr, tree, iterations)
+}
+
+func kruskalCSR[W graphs.Weight](g *graphs.CSRGraphOf[W]) ([]csrEdgeOf[W], int) {
+	verticesCount := g.VertexCount()
+	dsu := NewDSU(verticesCount)
 	edges := getSortedEdges(g)
-	edgesAdded := 0
-	verticesCount := len(g.Vertices)
+	tree := make([]csrEdgeOf[W], 0, verticesCount)
+	// A spanning forest has one edge less than vertices per component.
+	treeSize := verticesCount - len(csrComponents(g))
+	iterations := 0
 	for _, edge := range edges {
-		uID := vertexToID[edge.U]
-		vID := vertexToID[edge.V]
+		iterations++
+		uID := int(edge.u)
+		vID := int(edge.v)
 		if dsu.Find(uID) != dsu.Find(vID) {
-			mst.AddEdge(edge.U, edge.V, edge.Weight)
+			tree = append(tree, edge)
 			dsu.Union(uID, vID)
-			edgesAdded++
-			if edgesAdded == verticesCount-1 {
+			if len(tree) == treeSize {
 				break
 			}
 		}
 	}
-	return mst
+	return tree, iterations
 }
 
-func getSortedEdges(g *graphs.WeightedGraph) []graphs.WeightedEdge {
-	edges := g.GetEdges()
-	sort.Slice(edges, func(i, j int) bool {
-		w1, w2 := edges[i].Weight, edges[j].Weight
-
-		if w1 != w2 {
-			return w1 < w2
-		} else {
-			return edges[i].U < edges[j].U
-		}
-	})
+func getSortedEdges[W graphs.Weight](g *graphs.CSRGraphOf[W]) []csrEdgeOf[W] {
+	edges := csrEdges(g)
+	slices.SortFunc(edges, compareCSREdges[W])
 	return edges
 }
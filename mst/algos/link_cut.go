@@ -0,0 +1,180 @@
+package mst
+
+// nilNode marks a missing child or parent in a linkCut forest.
+const nilNode = -1
+
+// linkCut is a forest of link-cut trees (Sleator and Tarjan) that answers
+// path-maximum queries. Every node may carry a value; heaviest[x] is the
+// node with the greatest value in the splay subtree of x, or nilNode.
+//
+// All operations take O(log n) amortized time.
+type linkCut struct {
+	left, right, parent []int32
+	// reversed marks subtrees whose children still have to be swapped.
+	reversed []bool
+	heaviest []int32
+	hasValue []bool
+	// greater compares the values of two nodes that have one.
+	greater func(a, b int32) bool
+}
+
+// add creates a node with no value and returns it.
+func (t *linkCut) add() int32 {
+	t.left = append(t.left, nilNode)
+	t.right = append(t.right, nilNode)
+	t.parent = append(t.parent, nilNode)
+	t.reversed = append(t.reversed, false)
+	t.heaviest = append(t.heaviest, nilNode)
+	t.hasValue = append(t.hasValue, false)
+	return int32(len(t.left) - 1)
+}
+
+// reset turns x into a single node, with or without a value.
+func (t *linkCut) reset(x int32, hasValue bool) {
+	t.left[x], t.right[x], t.parent[x], t.reversed[x] = nilNode, nilNode, nilNode, false
+	t.hasValue[x] = hasValue
+	t.pull(x)
+}
+
+func (t *linkCut) isRoot(x int32) bool {
+	p := t.parent[x]
+	return p == nilNode || (t.left[p] != x && t.right[p] != x)
+}
+
+func (t *linkCut) push(x int32) {
+	if !t.reversed[x] {
+		return
+	}
+	t.left[x], t.right[x] = t.right[x], t.left[x]
+	for _, c := range []int32{t.left[x], t.right[x]} {
+		if c != nilNode {
+			t.reversed[c] = !t.reversed[c]
+		}
+	}
+	t.reversed[x] = false
+}
+
+func (t *linkCut) pull(x int32) {
+	best := int32(nilNode)
+	if t.hasValue[x] {
+		best = x
+	}
+	for _, c := range []int32{t.left[x], t.right[x]} {
+		if c == nilNode {
+			continue
+		}
+		if h := t.heaviest[c]; h != nilNode && (best == nilNode || t.greater(h, best)) {
+			best = h
+		}
+	}
+	t.heaviest[x] = best
+}
+
+func (t *linkCut) rotate(x int32) {
+	p := t.parent[x]
+	g := t.parent[p]
+	if !t.isRoot(p) {
+		if t.left[g] == p {
+			t.left[g] = x
+		} else {
+			t.right[g] = x
+		}
+	}
+	t.parent[x] = g
+	if t.left[p] == x {
+		t.left[p] = t.right[x]
+		if t.right[x] != nilNode {
+			t.parent[t.right[x]] = p
+		}
+		t.right[x] = p
+	} else {
+		t.right[p] = t.left[x]
+		if t.left[x] != nilNode {
+			t.parent[t.left[x]] = p
+		}
+		t.left[x] = p
+	}
+	t.parent[p] = x
+	t.pull(p)
+	t.pull(x)
+}
+
+func (t *linkCut) splay(x int32) {
+	path := []int32{x}
+	for y := x; !t.isRoot(y); y = t.parent[y] {
+		path = append(path, t.parent[y])
+	}
+	for i := len(path) - 1; i >= 0; i-- {
+		t.push(path[i])
+	}
+	for !t.isRoot(x) {
+		p := t.parent[x]
+		if !t.isRoot(p) {
+			g := t.parent[p]
+			if (t.left[g] == p) == (t.left[p] == x) {
+				t.rotate(p)
+			} else {
+				t.rotate(x)
+			}
+		}
+		t.rotate(x)
+	}
+}
+
+// access makes the path from the root of the tree to x preferred and
+// splays x to the top of it.
+func (t *linkCut) access(x int32) {
+	last := int32(nilNode)
+	for y := x; y != nilNode; y = t.parent[y] {
+		t.splay(y)
+		t.right[y] = last
+		t.pull(y)
+		last = y
+	}
+	t.splay(x)
+}
+
+func (t *linkCut) makeRoot(x int32) {
+	t.access(x)
+	t.reversed[x] = !t.reversed[x]
+}
+
+func (t *linkCut) findRoot(x int32) int32 {
+	t.access(x)
+	for {
+		t.push(x)
+		if t.left[x] == nilNode {
+			break
+		}
+		x = t.left[x]
+	}
+	t.splay(x)
+	return x
+}
+
+func (t *linkCut) connected(x, y int32) bool {
+	return x == y || t.findRoot(x) == t.findRoot(y)
+}
+
+// link joins the trees of x and y by the edge x-y; they must be different
+// trees.
+func (t *linkCut) link(x, y int32) {
+	t.makeRoot(x)
+	t.parent[x] = y
+}
+
+// cut removes the edge x-y, which must exist.
+func (t *linkCut) cut(x, y int32) {
+	t.makeRoot(x)
+	t.access(y)
+	t.left[y], t.parent[x] = nilNode, nilNode
+	t.pull(y)
+}
+
+// pathMax returns the node with the greatest value on the path between x
+// and y, which must be connected, or nilNode if no node there has a value.
+func (t *linkCut) pathMax(x, y int32) int32 {
+	t.makeRoot(x)
+	t.access(y)
+	return t.heaviest[y]
+}
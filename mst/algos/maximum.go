@@ -0,0 +1,59 @@
+package mst
+
+import (
+	"iter"
+
+	"github.com/Salvatore112/graph_analysis_algorithms/graphs"
+)
+
+// MaximumSpanningTree builds a maximum-weight spanning forest of g with
+// algorithm, which runs on g with every weight w replaced by ^w. Unlike -w,
+// ^w = -w-1 reverses the order of all ints, math.MinInt included. Ties are
+// broken by the endpoints just like for minimum spanning trees.
+func MaximumSpanningTree(g graphs.Weighted, algorithm MSTAlogorithm) *MSTResult {
+	res := algorithm(&reversed{g})
+	res.TotalWeight = 0
+	for i := range res.Edges {
+		res.Edges[i].Weight = ^res.Edges[i].Weight
+		res.TotalWeight += res.Edges[i].Weight
+	}
+	tree := graphs.NewWeightedGraph()
+	for v := range res.Tree.AllVertices() {
+		tree.AddVertex(v)
+	}
+	for _, e := range res.Edges {
+		tree.AddEdge(e.U, e.V, e.Weight)
+	}
+	res.Tree = tree
+	return res
+}
+
+// reversed is a view of a weighted graph with every weight w replaced by ^w.
+type reversed struct {
+	graphs.Weighted
+}
+
+func (g *reversed) WeightedNeighbors(vertex string) iter.Seq2[string, int] {
+	return func(yield func(string, int) bool) {
+		for v, w := range g.Weighted.WeightedNeighbors(vertex) {
+			if !yield(v, ^w) {
+				return
+			}
+		}
+	}
+}
+
+func (g *reversed) WeightedEdges() iter.Seq[graphs.WeightedEdge] {
+	return func(yield func(graphs.WeightedEdge) bool) {
+		for e := range g.Weighted.WeightedEdges() {
+			if !yield(graphs.WeightedEdge{U: e.U, V: e.V, Weight: ^e.Weight}) {
+				return
+			}
+		}
+	}
+}
+
+func (g *reversed) GetEdgeWeight(vertex1, vertex2 string) (int, bool) {
+	w, ok := g.Weighted.GetEdgeWeight(vertex1, vertex2)
+	return ^w, ok
+}
@@ -1,98 +1,261 @@
 package mst
 
 import (
-	"maps"
+	"reflect"
+	"slices"
 	"testing"
 
 	"github.com/Salvatore112/graph_analysis_algorithms/graphs"
+	"github.com/Salvatore112/graph_analysis_algorithms/graphs/generators"
 )
 
-var edges map[graphs.WeightedEdge]struct{}
-var edgesExpected1 map[graphs.WeightedEdge]struct{}
-var edgesExpected2 map[graphs.WeightedEdge]struct{}
+var edges []graphs.WeightedEdge
+var edgesExpected []graphs.WeightedEdge
 
 func init() {
-	edges = map[graphs.WeightedEdge]struct{}{
-		{U: "1", V: "2", Weight: 10}: {},
-		{U: "1", V: "5", Weight: 14}: {},
-		{U: "2", V: "3", Weight: 17}: {},
-		{U: "2", V: "4", Weight: 15}: {},
-		{U: "2", V: "5", Weight: 13}: {},
-		{U: "3", V: "4", Weight: 19}: {},
-		{U: "3", V: "7", Weight: 15}: {},
-		{U: "4", V: "5", Weight: 15}: {},
-		{U: "4", V: "7", Weight: 16}: {},
-		{U: "5", V: "6", Weight: 20}: {},
-		{U: "6", V: "7", Weight: 19}: {},
-	}
-
-	edgesExpected1 = map[graphs.WeightedEdge]struct{}{
-		{U: "1", V: "2", Weight: 10}: {},
-		{U: "2", V: "4", Weight: 15}: {},
-		{U: "2", V: "5", Weight: 13}: {},
-		{U: "3", V: "7", Weight: 15}: {},
-		{U: "4", V: "7", Weight: 16}: {},
-		{U: "6", V: "7", Weight: 19}: {},
-	}
-
-	edgesExpected2 = map[graphs.WeightedEdge]struct{}{
-		{U: "1", V: "2", Weight: 10}: {},
-		{U: "2", V: "5", Weight: 13}: {},
-		{U: "3", V: "7", Weight: 15}: {},
-		{U: "4", V: "7", Weight: 16}: {},
-		{U: "4", V: "5", Weight: 15}: {},
-		{U: "6", V: "7", Weight: 19}: {},
+	edges = []graphs.WeightedEdge{
+		{U: "1", V: "2", Weight: 10},
+		{U: "1", V: "5", Weight: 14},
+		{U: "2", V: "3", Weight: 17},
+		{U: "2", V: "4", Weight: 15},
+		{U: "2", V: "5", Weight: 13},
+		{U: "3", V: "4", Weight: 19},
+		{U: "3", V: "7", Weight: 15},
+		{U: "4", V: "5", Weight: 15},
+		{U: "4", V: "7", Weight: 16},
+		{U: "5", V: "6", Weight: 20},
+		{U: "6", V: "7", Weight: 19},
+	}
+
+	edgesExpected = []graphs.WeightedEdge{
+		{U: "1", V: "2", Weight: 10},
+		{U: "2", V: "4", Weight: 15},
+		{U: "2", V: "5", Weight: 13},
+		{U: "3", V: "7", Weight: 15},
+		{U: "4", V: "7", Weight: 16},
+		{U: "6", V: "7", Weight: 19},
+	}
+}
+
+func graphOf(edges []graphs.WeightedEdge) *graphs.WeightedGraph {
+	graph := graphs.NewWeightedGraph()
+	for _, edge := range edges {
+		graph.AddEdge(edge.U, edge.V, edge.Weight)
+	}
+	return graph
+}
+
+// algorithms are the MST algorithms that the shared tests run against.
+var algorithms = map[string]MSTAlogorithm{
+	"kruskal": KruskalMST,
+	"prim":    PrimMST,
+	"boruvka": BoruvkaMST,
+}
+
+// forEachAlgorithm runs test as a subtest for each of algorithms.
+func forEachAlgorithm(t *testing.T, test func(t *testing.T, mstAlgorithm MSTAlogorithm)) {
+	t.Helper()
+	for name, mstAlgorithm := range algorithms {
+		t.Run(name, func(t *testing.T) {
+			test(t, mstAlgorithm)
+		})
 	}
 }
 
 func TestMST(t *testing.T) {
 	type args struct {
-		edges        map[graphs.WeightedEdge]struct{}
+		edges        []graphs.WeightedEdge
 		mstAlgorithm MSTAlogorithm
 	}
 	tests := []struct {
 		name          string
 		args          args
-		edgesExpected [](map[graphs.WeightedEdge]struct{})
+		edgesExpected []graphs.WeightedEdge
 	}{
 		{
 			name:          "kruskal_test1",
 			args:          args{edges, KruskalMST},
-			edgesExpected: [](map[graphs.WeightedEdge]struct{}){edgesExpected1, edgesExpected2},
+			edgesExpected: edgesExpected,
 		},
 		{
 			name:          "prim_test1",
 			args:          args{edges, PrimMST},
-			edgesExpected: [](map[graphs.WeightedEdge]struct{}){edgesExpected1, edgesExpected2},
+			edgesExpected: edgesExpected,
 		},
 		{
 			name:          "boruvka_test1",
 			args:          args{edges, BoruvkaMST},
-			edgesExpected: [](map[graphs.WeightedEdge]struct{}){edgesExpected1, edgesExpected2},
+			edgesExpected: edgesExpected,
 		},
 	}
 	for _, tt := range tests {
 
 		t.Run(tt.name, func(t *testing.T) {
-			graph := graphs.NewWeightedGraph()
-			for edge := range edges {
-				graph.AddEdge(edge.U, edge.V, edge.Weight)
+			mst := tt.args.mstAlgorithm(graphOf(tt.args.edges)).Tree
+			if expected := graphOf(tt.edgesExpected); !mst.Equal(expected) {
+				t.Errorf("Expected edges %v, got %v (diff %+v)", tt.edgesExpected, mst.SortedEdges(), mst.Diff(expected))
 			}
-			mst := tt.args.mstAlgorithm(graph)
-			resultEdges := make(map[graphs.WeightedEdge]struct{})
-			for _, e := range mst.GetEdges() {
-				resultEdges[e] = struct{}{}
+		})
+	}
+}
+
+func TestMSTWithFloatWeights(t *testing.T) {
+	// The weights divided by 8 keep their order but mostly fall in [1, 2].
+	scaled := func(edges []graphs.WeightedEdge) *graphs.WeightedGraphOf[string, float64] {
+		graph := graphs.NewWeightedGraphOf[string, float64]()
+		for _, e := range edges {
+			graph.AddEdge(e.U, e.V, float64(e.Weight)/8)
+		}
+		return graph
+	}
+	graph, expected := scaled(edges), scaled(edgesExpected)
+
+	for name, mstAlgorithm := range map[string]func(graphs.WeightedOf[float64]) *MSTResultOf[float64]{
+		"kruskal": KruskalMSTOf[float64],
+		"prim":    PrimMSTOf[float64],
+		"boruvka": BoruvkaMSTOf[float64],
+	} {
+		t.Run(name, func(t *testing.T) {
+			res := mstAlgorithm(graph)
+			if !res.Tree.Equal(expected) {
+				t.Errorf("Expected edges %v, got %v", expected.SortedEdges(), res.Tree.SortedEdges())
 			}
-			res := false
-			for _, edgesExpected := range tt.edgesExpected {
-				if maps.Equal(resultEdges, edgesExpected) {
-					res = true
-					break
+			if res.TotalWeight != 11 {
+				t.Errorf("Expected total weight 11, got %v", res.TotalWeight)
+			}
+		})
+	}
+}
+
+func TestMSTOnCSR(t *testing.T) {
+	csr := graphs.ToCSR(graphOf(edges))
+	expected := graphOf(edgesExpected)
+
+	forEachAlgorithm(t, func(t *testing.T, mstAlgorithm MSTAlogorithm) {
+		if mst := mstAlgorithm(csr).Tree; !mst.Equal(expected) {
+			t.Errorf("Expected edges %v, got %v", edgesExpected, mst.SortedEdges())
+		}
+	})
+}
+
+func TestMSTTieBreak(t *testing.T) {
+	// With all weights equal every spanning tree is minimal; the tie-break
+	// picks the star around the smallest vertex.
+	vertices := []string{"A", "B", "C", "D", "E"}
+	expected := graphOf([]graphs.WeightedEdge{
+		{U: "A", V: "B", Weight: 1},
+		{U: "A", V: "C", Weight: 1},
+		{U: "A", V: "D", Weight: 1},
+		{U: "A", V: "E", Weight: 1},
+	})
+
+	forEachAlgorithm(t, func(t *testing.T, mstAlgorithm MSTAlogorithm) {
+		for i := range len(vertices) {
+			graph := graphs.NewWeightedGraph()
+			for j := range vertices {
+				for k := range j {
+					u, v := vertices[(j+i)%len(vertices)], vertices[(k+i)%len(vertices)]
+					graph.AddEdge(u, v, 1)
 				}
 			}
-			if !res {
-				t.Errorf("Expected edges %v, got %v", tt.edgesExpected, resultEdges)
+			if mst := mstAlgorithm(graph).Tree; !mst.Equal(expected) {
+				t.Errorf("Expected edges %v, got %v", expected.SortedEdges(), mst.SortedEdges())
 			}
-		})
+		}
+	})
+}
+
+func TestMSTByAttribute(t *testing.T) {
+	// The graph weights are all 1, the MST must follow the "cost" attribute.
+	cost := graphs.NewKey[int]("cost")
+	graph := graphs.NewWeightedGraph()
+	for _, edge := range edges {
+		graph.AddEdge(edge.U, edge.V, 1)
+		graphs.SetEdgeAttr(graph.Attrs(), edge.U, edge.V, cost, edge.Weight)
+	}
+	expected := graphOf(edgesExpected)
+
+	if mst := PrimMST(graphs.WeightedBy(graph, cost)).Tree; !mst.Equal(expected) {
+		t.Errorf("Expected edges %v, got %v", edgesExpected, mst.SortedEdges())
+	}
+}
+
+func TestMinimumSpanningForest(t *testing.T) {
+	// Two copies of the test graph, a single edge and an isolated vertex.
+	graph := graphOf(edges)
+	for _, edge := range edges {
+		graph.AddEdge("b"+edge.U, "b"+edge.V, edge.Weight)
+	}
+	graph.AddEdge("x", "y", 7)
+	graph.AddVertex("z")
+	expectedWeight := 2*(10+15+13+15+16+19) + 7
+
+	forEachAlgorithm(t, func(t *testing.T, mstAlgorithm MSTAlogorithm) {
+		res := mstAlgorithm(graph)
+		if len(res.Components) != 4 || res.TotalWeight != expectedWeight || len(res.Edges) != 13 {
+			t.Fatalf("Expected 13 edges in 4 trees of weight %d, got %d in %d of weight %d",
+				expectedWeight, len(res.Edges), len(res.Components), res.TotalWeight)
+		}
+		if res.Tree.VertexCount() != graph.VertexCount() || !res.Tree.HasVertex("z") {
+			t.Errorf("Expected the forest to keep all %d vertices, got %v", graph.VertexCount(), res.Tree.SortedVertices())
+		}
+
+		trees := res.Trees()
+		if !trees[0].Equal(graphOf(edgesExpected)) {
+			t.Errorf("Unexpected first tree %v", trees[0].SortedEdges())
+		}
+		if trees[1].VertexCount() != 7 || trees[1].EdgeCount() != 6 {
+			t.Errorf("Unexpected second tree %v", trees[1].SortedEdges())
+		}
+		if last := trees[3]; last.VertexCount() != 1 || !last.HasVertex("z") || last.EdgeCount() != 0 {
+			t.Errorf("Expected the isolated vertex z to form a tree, got %v", last.SortedVertices())
+		}
+		if !reflect.DeepEqual(res.Components[2], []string{"x", "y"}) {
+			t.Errorf("Unexpected component %v", res.Components[2])
+		}
+	})
+}
+
+func TestMSTResultEdgeOrder(t *testing.T) {
+	graph := graphOf(edges)
+
+	kruskal := KruskalMST(graph)
+	if !slices.IsSortedFunc(kruskal.Edges, graphs.CompareWeightedEdges) {
+		t.Errorf("Kruskal must pick edges by increasing weight, got %v", kruskal.Edges)
+	}
+	// The last tree edge, 6-7, is the 10th one in the sorted order.
+	if kruskal.Iterations != 10 {
+		t.Errorf("Expected Kruskal to examine 10 edges, got %d", kruskal.Iterations)
+	}
+
+	prim := PrimMST(graph)
+	if first := prim.Edges[0]; first != (graphs.WeightedEdge{U: "1", V: "2", Weight: 10}) {
+		t.Errorf("Prim must start at the cheapest edge of vertex 1, got %v", first)
+	}
+	if prim.Iterations < 2*graph.VertexCount() {
+		t.Errorf("Expected at least a push and a pop per vertex, got %d heap operations", prim.Iterations)
+	}
+
+	boruvka := BoruvkaMST(graph)
+	if boruvka.Iterations != 3 {
+		t.Errorf("Expected 3 Boruvka rounds, got %d", boruvka.Iterations)
+	}
+	for _, res := range []*MSTResult{kruskal, prim, boruvka} {
+		if res.TotalWeight != 10+15+13+15+16+19 {
+			t.Errorf("Unexpected total weight %d", res.TotalWeight)
+		}
+	}
+}
+
+func TestMSTAlgorithmsAgreeOnForests(t *testing.T) {
+	for seed := range uint64(5) {
+		// About 120 edges on 100 vertices leave many components.
+		graph := generators.GNM(100, 120, seed).Weighted(generators.UniformWeights(1, 5), seed)
+		expected := KruskalMST(graph).Tree
+		for name, mstAlgorithm := range algorithms {
+			if mst := mstAlgorithm(graph).Tree; !mst.Equal(expected) {
+				t.Errorf("seed %d: %s differs from Kruskal: %+v", seed, name, mst.Diff(expected))
+			}
+		}
 	}
 }
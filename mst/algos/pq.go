@@ -6,20 +6,28 @@ import (
 	"golang.org/x/exp/constraints"
 )
 
-type Node[V comparable, P constraints.Ordered] struct {
+type Node[V comparable, P any] struct {
 	Value    V
 	Priority P
 }
 
-type PriorityQueue[V comparable, P constraints.Ordered] struct {
+type PriorityQueue[V comparable, P any] struct {
 	heap []Node[V, P]
 	set  map[V]int // for fast Update func
+	less func(a, b P) bool
 }
 
 func NewPQ[V comparable, P constraints.Ordered]() *PriorityQueue[V, P] {
+	return NewPQFunc[V](func(a, b P) bool { return a < b })
+}
+
+// NewPQFunc creates a queue whose priorities are ordered by less,
+// e.g. to break ties between equal weights.
+func NewPQFunc[V comparable, P any](less func(a, b P) bool) *PriorityQueue[V, P] {
 	pq := &PriorityQueue[V, P]{
 		heap: make([]Node[V, P], 0),
-		set:  make(map[V]int)}
+		set:  make(map[V]int),
+		less: less}
 	heap.Init(pq)
 	return pq
 }
@@ -44,7 +52,7 @@ func (pq *PriorityQueue[V, P]) Len() int {
 
 // Less implements heap.Interface.
 func (pq *PriorityQueue[V, P]) Less(i int, j int) bool {
-	return pq.heap[i].Priority < pq.heap[j].Priority
+	return pq.less(pq.heap[i].Priority, pq.heap[j].Priority)
 }
 
 // Swap implements heap.Interface.
@@ -63,6 +71,14 @@ func (pq *PriorityQueue[V, P]) Push(node any) {
 	heap.Fix(pq, len(pq.heap)-1)
 }
 
+// Peek returns the node with the smallest priority without removing it.
+func (pq *PriorityQueue[V, P]) Peek() Node[V, P] {
+	if pq.IsEmpty() {
+		panic("Queue is empty")
+	}
+	return pq.heap[0]
+}
+
 func (pq *PriorityQueue[V, P]) IsEmpty() bool {
 	return pq.Len() == 0
 }
@@ -142,3 +142,36 @@ func TestPriorityQueue_StringValue(t *testing.T) {
 		t.Errorf("Expected banana, got %s", item3.Value)
 	}
 }
+
+func TestPriorityQueue_Func(t *testing.T) {
+	type key struct{ weight, tie int }
+	pq := NewPQFunc[string](func(a, b key) bool {
+		if a.weight != b.weight {
+			return a.weight < b.weight
+		}
+		return a.tie < b.tie
+	})
+
+	pq.Push(Node[string, key]{Value: "A", Priority: key{1, 2}})
+	pq.Push(Node[string, key]{Value: "B", Priority: key{2, 0}})
+	pq.Push(Node[string, key]{Value: "C", Priority: key{1, 1}})
+
+	for _, expected := range []string{"C", "A", "B"} {
+		if item := pq.Pop().(Node[string, key]); item.Value != expected {
+			t.Errorf("Expected %s, got %s", expected, item.Value)
+		}
+	}
+}
+
+func TestPriorityQueue_Peek(t *testing.T) {
+	pq := NewPQ[string, int]()
+	pq.Push(Node[string, int]{Value: "A", Priority: 3})
+	pq.Push(Node[string, int]{Value: "B", Priority: 1})
+
+	if top := pq.Peek(); top.Value != "B" || top.Priority != 1 {
+		t.Errorf("Expected B with priority 1, got %v", top)
+	}
+	if pq.Len() != 2 {
+		t.Errorf("Peek must not remove nodes, got length %d", pq.Len())
+	}
+}
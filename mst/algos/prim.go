@@ -1,51 +1,75 @@
 package mst
 
 import (
-	"math"
-
 	"github.com/Salvatore112/graph_analysis_algorithms/graphs"
 )
 
 const NO_PARENT_ID_PRIM = -1
-const START_VERTEX_INDEX = 0
 
-func PrimMST(g *graphs.WeightedGraph) (mst *graphs.WeightedGraph) {
-	mst = graphs.NewWeightedGraph()
-	idToVertex := make([]string, len(g.Vertices))
-	vertexToID := map[string]int{}
-	i := 0
-	key := make([]int, len(g.Vertices))
-	parent := make([]int, len(g.Vertices))
-	for vertex := range g.Vertices {
-		idToVertex[i] = vertex
-		vertexToID[vertex] = i
-		key[i] = math.MaxInt
-		parent[i] = NO_PARENT_ID_PRIM
-		i++
-	}
-	key[START_VERTEX_INDEX] = 0
-	q := NewPQ[int, int]()
+// PrimMST grows the tree from one vertex, always adding the cheapest edge
+// leaving it. Its Iterations count the pushes, updates and pops of the heap.
+func PrimMST(g graphs.Weighted) *MSTResult {
+	return PrimMSTOf(g)
+}
 
-	for i := range len(g.Vertices) {
-		q.Push(Node[int, int]{i, key[i]})
-	}
+// PrimMSTOf is PrimMST for weights of any type.
+func PrimMSTOf[W graphs.Weight](g graphs.WeightedOf[W]) *MSTResultOf[W] {
+	csr := graphs.ToCSROf[W](g)
+	tree, heapOps := primCSR(csr)
+	return newResult(csr, tree, heapOps)
+}
 
-	for q.Len() > 0 {
-		u := q.Pop().(Node[int, int])
-		for vKey, weight := range g.GetNeighbors(idToVertex[u.Value]) {
-			vId := vertexToID[vKey]
-			if q.Contains(vId) && weight < key[vId] {
-				parent[vId] = u.Value
-				key[vId] = weight
-				q.Update(vId, key[vId])
-			}
-		}
+// primCSR grows a tree from the smallest vertex of every connected
+// component in turn, so disconnected graphs get a spanning forest.
+func primCSR[W graphs.Weight](g *graphs.CSRGraphOf[W]) ([]csrEdgeOf[W], int) {
+	n := g.VertexCount()
+	edges := make([]csrEdgeOf[W], 0, n)
+	// key[v] is the cheapest known edge between v and the tree, kept with
+	// u < v so that it compares exactly like the edges in Kruskal. It is
+	// only meaningful once parent[v] is set.
+	key := make([]csrEdgeOf[W], n)
+	parent := make([]int32, n)
+	inTree := make([]bool, n)
+	for i := range n {
+		parent[i] = NO_PARENT_ID_PRIM
 	}
+	q := NewPQFunc[int32](func(a, b csrEdgeOf[W]) bool { return compareCSREdges(a, b) < 0 })
+	heapOps := 0
 
-	for i, parentId := range parent {
-		if parentId != NO_PARENT_ID_PRIM {
-			mst.AddEdge(idToVertex[i], idToVertex[parentId], key[i])
+	for root := range int32(n) {
+		if inTree[root] {
+			continue
+		}
+		// The root is alone in the queue, so its key is never compared.
+		key[root] = csrEdgeOf[W]{NO_PARENT_ID_PRIM, NO_PARENT_ID_PRIM, 0}
+		q.Push(Node[int32, csrEdgeOf[W]]{root, key[root]})
+		heapOps++
+		for q.Len() > 0 {
+			u := q.Pop().(Node[int32, csrEdgeOf[W]]).Value
+			heapOps++
+			inTree[u] = true
+			if parent[u] != NO_PARENT_ID_PRIM {
+				edges = append(edges, key[u])
+			}
+			lo, hi := g.ArcRange(u)
+			for i := lo; i < hi; i++ {
+				v := g.Target(i)
+				if inTree[v] {
+					continue
+				}
+				edge := csrEdgeOf[W]{min(u, v), max(u, v), g.ArcWeight(i)}
+				if parent[v] == NO_PARENT_ID_PRIM || compareCSREdges(edge, key[v]) < 0 {
+					parent[v] = u
+					key[v] = edge
+					if q.Contains(v) {
+						q.Update(v, key[v])
+					} else {
+						q.Push(Node[int32, csrEdgeOf[W]]{v, key[v]})
+					}
+					heapOps++
+				}
+			}
 		}
 	}
-	return mst
+	return edges, heapOps
 }
@@ -0,0 +1,75 @@
+package mst
+
+import (
+	"slices"
+
+	"github.com/Salvatore112/graph_analysis_algorithms/graphs"
+)
+
+// MSTResultOf describes a minimum spanning forest of a graph with weights
+// of type W.
+type MSTResultOf[W graphs.Weight] struct {
+	// Edges lists the chosen edges in the order the algorithm picked them,
+	// each with U < V.
+	Edges       []graphs.WeightedEdgeOf[string, W]
+	TotalWeight W
+	// Components holds the sorted vertices of every connected component of
+	// the input, ordered by their smallest vertex.
+	Components [][]string
+	// Iterations measures the work of the algorithm: the edges examined by
+	// Kruskal, the heap operations of Prim and the rounds of Boruvka.
+	Iterations int
+	// Tree is the forest as a graph. Unlike a graph built from Edges it
+	// contains every vertex of the input, including isolated ones.
+	Tree *graphs.WeightedGraphOf[string, W]
+}
+
+// MSTResult describes a minimum spanning forest built by an MSTAlogorithm.
+type MSTResult = MSTResultOf[int]
+
+// newResult translates forest edges back to vertex names.
+func newResult[W graphs.Weight](g *graphs.CSRGraphOf[W], edges []csrEdgeOf[W], iterations int) *MSTResultOf[W] {
+	res := &MSTResultOf[W]{
+		Edges:      make([]graphs.WeightedEdgeOf[string, W], len(edges)),
+		Components: make([][]string, 0),
+		Iterations: iterations,
+		Tree:       graphs.NewWeightedGraphOf[string, W](),
+	}
+	for _, v := range g.SortedVertices() {
+		res.Tree.AddVertex(v)
+	}
+	for i, e := range edges {
+		res.Edges[i] = graphs.WeightedEdgeOf[string, W]{U: g.Name(e.u), V: g.Name(e.v), Weight: e.weight}
+		res.Tree.AddEdge(g.Name(e.u), g.Name(e.v), e.weight)
+		res.TotalWeight += e.weight
+	}
+	// Vertex indices follow the name order, so sorted indices give sorted
+	// names.
+	for _, component := range csrComponents(g) {
+		slices.Sort(component)
+		names := make([]string, len(component))
+		for i, v := range component {
+			names[i] = g.Name(v)
+		}
+		res.Components = append(res.Components, names)
+	}
+	return res
+}
+
+// Trees splits the forest into one tree per component, in the order of
+// Components. A tree of an isolated vertex has no edges.
+func (r *MSTResultOf[W]) Trees() []*graphs.WeightedGraphOf[string, W] {
+	trees := make([]*graphs.WeightedGraphOf[string, W], len(r.Components))
+	componentOf := make(map[string]int)
+	for i, component := range r.Components {
+		trees[i] = graphs.NewWeightedGraphOf[string, W]()
+		for _, v := range component {
+			componentOf[v] = i
+			trees[i].AddVertex(v)
+		}
+	}
+	for _, e := range r.Edges {
+		trees[componentOf[e.U]].AddEdge(e.U, e.V, e.Weight)
+	}
+	return trees
+}
@@ -0,0 +1,267 @@
+package mst
+
+import (
+	"math"
+	"slices"
+
+	"github.com/Salvatore112/graph_analysis_algorithms/graphs"
+)
+
+// EdgeSensitivity tells how far the weight of an edge can change while the
+// minimum spanning forest stays minimal.
+//
+// A tree edge stays in a minimum forest while its weight is at most Limit,
+// the weight of the lightest non-tree edge that can replace it. A non-tree
+// edge stays out while its weight is at least Limit, the weight of the
+// heaviest tree edge on the cycle it closes. Bounded is false for tree
+// edges that nothing can replace (bridges); their weight may grow freely
+// and Limit is math.MaxInt.
+type EdgeSensitivity struct {
+	Edge    graphs.WeightedEdge
+	InTree  bool
+	Limit   int
+	Bounded bool
+}
+
+// Slack returns how much the weight may change in the allowed direction:
+// up for tree edges, down for non-tree edges. At this distance another
+// forest becomes equally good. It is math.MaxInt for unbounded edges.
+func (s EdgeSensitivity) Slack() int {
+	if !s.Bounded {
+		return math.MaxInt
+	}
+	if s.InTree {
+		return s.Limit - s.Edge.Weight
+	}
+	return s.Edge.Weight - s.Limit
+}
+
+// Sensitivity is a minimum spanning forest with the sensitivity of every
+// edge of the graph, listed in the canonical edge order.
+type Sensitivity struct {
+	MST   *MSTResult
+	Edges []EdgeSensitivity
+}
+
+// MSTSensitivity builds the minimum spanning forest of g with Kruskal's
+// algorithm and computes the sensitivity of every edge.
+//
+// Non-tree edges get the maximum of the tree path between their endpoints
+// from binary lifting tables. Tree edges are covered by the non-tree edges
+// in increasing order of weight; a union-find over the tree skips edges
+// that are already covered, so the whole analysis takes O(E log V).
+func MSTSensitivity(g graphs.Weighted) *Sensitivity {
+	csr := graphs.ToCSR(g)
+	tree, iterations := kruskalCSR(csr)
+	f := newRootedForest(csr.VertexCount(), tree)
+	edges := getSortedEdges(csr)
+
+	limits := make([]int, csr.VertexCount())
+	covered := make([]bool, csr.VertexCount())
+	for v := range limits {
+		limits[v] = math.MaxInt
+	}
+	// up[v] leads to the nearest vertex on the way to the root, v included,
+	// whose parent edge is not covered yet; see uncovered.
+	up := make([]int32, csr.VertexCount())
+	for v := range up {
+		up[v] = int32(v)
+	}
+	nonTree := make([]EdgeSensitivity, 0, len(edges)-len(tree))
+	inTree := f.treeEdges()
+	for _, e := range edges {
+		if inTree[e] > 0 {
+			inTree[e]--
+			continue
+		}
+		nonTree = append(nonTree, EdgeSensitivity{
+			Edge:    graphs.WeightedEdge{U: csr.Name(e.u), V: csr.Name(e.v), Weight: e.weight},
+			Limit:   f.pathMax(e.u, e.v).weight,
+			Bounded: true,
+		})
+		a, b := uncovered(up, e.u), uncovered(up, e.v)
+		for a != b {
+			if f.depth[a] < f.depth[b] {
+				a, b = b, a
+			}
+			limits[a], covered[a] = e.weight, true
+			up[a] = f.parent[a]
+			a = uncovered(up, a)
+		}
+	}
+
+	res := &Sensitivity{MST: newResult(csr, tree, iterations), Edges: make([]EdgeSensitivity, 0, len(edges))}
+	for _, e := range tree {
+		child := e.v
+		if f.parent[e.u] == e.v {
+			child = e.u
+		}
+		res.Edges = append(res.Edges, EdgeSensitivity{
+			Edge:    graphs.WeightedEdge{U: csr.Name(e.u), V: csr.Name(e.v), Weight: e.weight},
+			InTree:  true,
+			Limit:   limits[child],
+			Bounded: covered[child],
+		})
+	}
+	res.Edges = append(res.Edges, nonTree...)
+	slices.SortFunc(res.Edges, func(a, b EdgeSensitivity) int {
+		return graphs.CompareWeightedEdges(a.Edge, b.Edge)
+	})
+	return res
+}
+
+// uncovered follows up from v to a vertex that points to itself and
+// shortens the path on the way (path halving).
+func uncovered(up []int32, v int32) int32 {
+	for up[v] != v {
+		up[v] = up[up[v]]
+		v = up[v]
+	}
+	return v
+}
+
+// SecondBestMST returns the lightest spanning forest of g other than the
+// minimum one, obtained by swapping a single non-tree edge for the
+// heaviest tree edge on its cycle. It returns false if g has no other
+// spanning forest. Of equally good swaps the first non-tree edge in the
+// canonical order wins.
+//
+// The edges of the result are those of KruskalMST in their pick order, with
+// the new edge in the place of the edge it replaces.
+func SecondBestMST(g graphs.Weighted) (*MSTResult, bool) {
+	csr := graphs.ToCSR(g)
+	tree, _ := kruskalCSR(csr)
+	f := newRootedForest(csr.VertexCount(), tree)
+	inTree := f.treeEdges()
+
+	examined := 0
+	var best, removed csrEdge
+	found := false
+	for _, e := range getSortedEdges(csr) {
+		if inTree[e] > 0 {
+			inTree[e]--
+			continue
+		}
+		examined++
+		m := f.pathMax(e.u, e.v)
+		if !found || e.weight-m.weight < best.weight-removed.weight {
+			best, removed, found = e, m, true
+		}
+	}
+	if !found {
+		return nil, false
+	}
+
+	swapped := slices.Clone(tree)
+	swapped[slices.Index(swapped, removed)] = best
+	return newResult(csr, swapped, examined), true
+}
+
+// rootedForest roots every tree of a spanning forest at its smallest vertex
+// and keeps binary lifting tables for path-maximum queries.
+type rootedForest struct {
+	parent []int32
+	depth  []int
+	// lift[k][v] is the 2^k-th ancestor of v and heaviest[k][v] the
+	// heaviest edge on the way there, in the order of compareCSREdges.
+	lift     [][]int32
+	heaviest [][]csrEdge
+	edges    []csrEdge
+}
+
+func newRootedForest(n int, edges []csrEdge) *rootedForest {
+	adj := make([][]csrEdge, n)
+	for _, e := range edges {
+		adj[e.u] = append(adj[e.u], e)
+		adj[e.v] = append(adj[e.v], e)
+	}
+	f := &rootedForest{
+		parent: make([]int32, n),
+		depth:  make([]int, n),
+		edges:  edges,
+	}
+	toParent := make([]csrEdge, n)
+	seen := make([]bool, n)
+	for root := range int32(n) {
+		if seen[root] {
+			continue
+		}
+		seen[root] = true
+		f.parent[root] = root
+		toParent[root] = csrEdge{-1, -1, minWeight}
+		queue := []int32{root}
+		for i := 0; i < len(queue); i++ {
+			u := queue[i]
+			for _, e := range adj[u] {
+				v := e.u + e.v - u
+				if !seen[v] {
+					seen[v] = true
+					f.parent[v], f.depth[v] = u, f.depth[u]+1
+					toParent[v] = e
+					queue = append(queue, v)
+				}
+			}
+		}
+	}
+
+	f.lift = [][]int32{f.parent}
+	f.heaviest = [][]csrEdge{toParent}
+	for k := 1; 1<<k < n; k++ {
+		prev, prevMax := f.lift[k-1], f.heaviest[k-1]
+		lift, heaviest := make([]int32, n), make([]csrEdge, n)
+		for v := range n {
+			mid := prev[v]
+			lift[v] = prev[mid]
+			heaviest[v] = maxEdge(prevMax[v], prevMax[mid])
+		}
+		f.lift = append(f.lift, lift)
+		f.heaviest = append(f.heaviest, heaviest)
+	}
+	return f
+}
+
+// minWeight marks the missing parent edge of a root; it loses to every
+// real edge in maxEdge.
+const minWeight = math.MinInt
+
+func maxEdge(a, b csrEdge) csrEdge {
+	if compareCSREdges(a, b) >= 0 {
+		return a
+	}
+	return b
+}
+
+// treeEdges counts the tree edges so that parallel copies of an edge that
+// are not in the tree can be told apart.
+func (f *rootedForest) treeEdges() map[csrEdge]int {
+	res := make(map[csrEdge]int, len(f.edges))
+	for _, e := range f.edges {
+		res[e]++
+	}
+	return res
+}
+
+// pathMax returns the heaviest edge on the tree path between u and v,
+// which must be distinct vertices of the same tree.
+func (f *rootedForest) pathMax(u, v int32) csrEdge {
+	res := csrEdge{-1, -1, minWeight}
+	if f.depth[u] < f.depth[v] {
+		u, v = v, u
+	}
+	for k := len(f.lift) - 1; k >= 0; k-- {
+		if f.depth[u]-1<<k >= f.depth[v] {
+			res = maxEdge(res, f.heaviest[k][u])
+			u = f.lift[k][u]
+		}
+	}
+	if u == v {
+		return res
+	}
+	for k := len(f.lift) - 1; k >= 0; k-- {
+		if f.lift[k][u] != f.lift[k][v] {
+			res = maxEdge(res, maxEdge(f.heaviest[k][u], f.heaviest[k][v]))
+			u, v = f.lift[k][u], f.lift[k][v]
+		}
+	}
+	return maxEdge(res, maxEdge(f.heaviest[0][u], f.heaviest[0][v]))
+}
@@ -0,0 +1,111 @@
+package mst
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/Salvatore112/graph_analysis_algorithms/graphs"
+	"github.com/Salvatore112/graph_analysis_algorithms/graphs/generators"
+)
+
+func withWeight(graph *graphs.WeightedGraph, e graphs.WeightedEdge, w int) *graphs.WeightedGraph {
+	c := graph.Clone()
+	c.AddEdge(e.U, e.V, w)
+	return c
+}
+
+func checkSensitivity(t *testing.T, graph *graphs.WeightedGraph) {
+	t.Helper()
+	s := MSTSensitivity(graph)
+	if !s.MST.Tree.Equal(KruskalMST(graph).Tree) {
+		t.Fatalf("Expected the Kruskal tree")
+	}
+	if len(s.Edges) != graph.EdgeCount() {
+		t.Fatalf("Expected %d edges, got %d", graph.EdgeCount(), len(s.Edges))
+	}
+	weight := s.MST.TotalWeight
+	for _, es := range s.Edges {
+		e := es.Edge
+		if es.InTree != s.MST.Tree.HasEdge(e.U, e.V) {
+			t.Fatalf("Edge %v: wrong InTree", e)
+		}
+		switch {
+		case es.InTree && !es.Bounded:
+			if got := KruskalMST(withWeight(graph, e, e.Weight+1000)).TotalWeight; got != weight+1000 {
+				t.Fatalf("Bridge %v must stay in the tree", e)
+			}
+		case es.InTree:
+			// At Limit the old tree is still minimal, above it is not.
+			if got := KruskalMST(withWeight(graph, e, es.Limit)).TotalWeight; got != weight+es.Slack() {
+				t.Fatalf("Edge %v at %d: expected weight %d, got %d", e, es.Limit, weight+es.Slack(), got)
+			}
+			if got := KruskalMST(withWeight(graph, e, es.Limit+1)).TotalWeight; got >= weight+es.Slack()+1 {
+				t.Fatalf("Edge %v above %d must leave the tree", e, es.Limit)
+			}
+		default:
+			if got := KruskalMST(withWeight(graph, e, es.Limit)).TotalWeight; got != weight {
+				t.Fatalf("Edge %v at %d: expected weight %d, got %d", e, es.Limit, weight, got)
+			}
+			if got := KruskalMST(withWeight(graph, e, es.Limit-1)).TotalWeight; got != weight-1 {
+				t.Fatalf("Edge %v below %d must enter the tree", e, es.Limit)
+			}
+		}
+		if es.Slack() < 0 {
+			t.Fatalf("Edge %v: negative slack %d", e, es.Slack())
+		}
+	}
+}
+
+func TestMSTSensitivity(t *testing.T) {
+	checkSensitivity(t, graphOf(edges))
+
+	s := MSTSensitivity(graphOf(edges))
+	for _, es := range s.Edges {
+		// 5-6 closes the cycle 5-2-4-7-6 whose heaviest tree edge is 6-7.
+		if es.Edge.U == "5" && es.Edge.V == "6" && (es.InTree || es.Limit != 19 || es.Slack() != 1) {
+			t.Errorf("Unexpected sensitivity of 5-6: %+v", es)
+		}
+		// 6-7 can only be replaced by 5-6.
+		if es.Edge.U == "6" && es.Edge.V == "7" && (!es.InTree || es.Limit != 20 || !es.Bounded) {
+			t.Errorf("Unexpected sensitivity of 6-7: %+v", es)
+		}
+	}
+
+	for seed := range uint64(5) {
+		checkSensitivity(t, generators.GNM(25, 40, seed).Weighted(generators.UniformWeights(1, 10), seed))
+	}
+}
+
+func TestSecondBestMST(t *testing.T) {
+	for seed := range uint64(5) {
+		graph := generators.GNM(20, 50, seed).Weighted(generators.UniformWeights(1, 20), seed)
+		second, ok := SecondBestMST(graph)
+		if !ok {
+			t.Fatalf("Expected a second best tree")
+		}
+		expected := KBestSpanningTrees(graph, 2)[1]
+		if second.TotalWeight != expected.TotalWeight {
+			t.Errorf("seed %d: expected weight %d, got %d", seed, expected.TotalWeight, second.TotalWeight)
+		}
+		if second.Tree.Equal(KruskalMST(graph).Tree) || len(second.Edges) != len(expected.Edges) {
+			t.Errorf("seed %d: expected a different spanning forest", seed)
+		}
+	}
+
+	// Kruskal picks A-B, B-C, C-D; A-C takes the place of B-C.
+	second, ok := SecondBestMST(graphOf([]graphs.WeightedEdge{
+		{U: "A", V: "B", Weight: 1}, {U: "B", V: "C", Weight: 2}, {U: "C", V: "D", Weight: 5}, {U: "A", V: "C", Weight: 3},
+	}))
+	if !ok {
+		t.Fatalf("Expected a second best tree")
+	}
+	swapped := []graphs.WeightedEdge{{U: "A", V: "B", Weight: 1}, {U: "A", V: "C", Weight: 3}, {U: "C", V: "D", Weight: 5}}
+	if !slices.Equal(second.Edges, swapped) {
+		t.Errorf("Expected edges %v, got %v", swapped, second.Edges)
+	}
+
+	tree := graphOf([]graphs.WeightedEdge{{U: "A", V: "B", Weight: 1}, {U: "B", V: "C", Weight: 2}})
+	if _, ok := SecondBestMST(tree); ok {
+		t.Errorf("A tree has no second best spanning tree")
+	}
+}
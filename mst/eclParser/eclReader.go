@@ -11,83 +11,148 @@ import (
 	"github.com/Salvatore112/graph_analysis_algorithms/graphs"
 )
 
+// ReadECLgraph reads an ECL graph naming every vertex by its decimal index.
 func ReadECLgraph(filename string) (*graphs.WeightedGraph, error) {
+	return readECLgraph(filename, func(id int32) string { return strconv.Itoa(int(id)) })
+}
+
+// ReadECLgraphIndexed reads an ECL graph keeping the integer vertex indices,
+// which avoids converting every vertex ID to a string.
+func ReadECLgraphIndexed(filename string) (*graphs.WeightedGraphOf[int32, int], error) {
+	return readECLgraph(filename, func(id int32) int32 { return id })
+}
+
+// ReadECLCSR reads an ECL graph into a CSRGraph, keeping the index and
+// neighbor arrays of the file as they are. Weights are stored as int32 in
+// the file and are widened to int.
+//
+// Files without weights get the same pseudo-random weights as in
+// ReadECLgraph.
+func ReadECLCSR(filename string) (*graphs.CSRGraph, error) {
+	nindex, nlist, eweight, err := readECLArrays(filename)
+	if err != nil {
+		return nil, err
+	}
+	return graphs.NewCSRGraph(nindex, nlist, arcWeights(nindex, nlist, eweight), false)
+}
+
+// arcWeights returns the weight of every arc: the weight from the file or,
+// if the file has none, a weight in [0, 1000) drawn from a generator seeded
+// with 64, one per arc in file order. Both arcs of an edge get the weight
+// drawn for the later one, which is the weight ReadECLgraph has always kept.
+// nindex and nlist must be valid.
+func arcWeights(nindex, nlist, eweight []int32) []int {
+	weights := make([]int, len(nlist))
+	if eweight != nil {
+		for i, w := range eweight {
+			weights[i] = int(w)
+		}
+		return weights
+	}
+
+	rand := rand.New(rand.NewSource(64))
+	last := make(map[[2]int32]int, len(nlist)/2)
+	for u := int32(0); u+1 < int32(len(nindex)); u++ {
+		for i := nindex[u]; i < nindex[u+1]; i++ {
+			last[edgeKey(u, nlist[i])] = rand.Int() % 1000
+		}
+	}
+	for u := int32(0); u+1 < int32(len(nindex)); u++ {
+		for i := nindex[u]; i < nindex[u+1]; i++ {
+			weights[i] = last[edgeKey(u, nlist[i])]
+		}
+	}
+	return weights
+}
+
+// edgeKey names the unordered pair {u, v}.
+func edgeKey(u, v int32) [2]int32 {
+	if u > v {
+		u, v = v, u
+	}
+	return [2]int32{u, v}
+}
+
+// readECLArrays reads the raw CSR arrays of an ECL file and checks that
+// they describe a graph. eweight is nil if the file carries no weights.
+func readECLArrays(filename string) (nindex, nlist, eweight []int32, err error) {
 	file, err := os.Open(filename)
 	if err != nil {
-		return nil, fmt.Errorf("failed to open file: %v", err)
+		return nil, nil, nil, fmt.Errorf("failed to open file: %v", err)
 	}
 	defer file.Close()
 
 	var nodes, edges int32
 
 	if err := binary.Read(file, binary.LittleEndian, &nodes); err != nil {
-		return nil, fmt.Errorf("failed to read nodes: %v", err)
+		return nil, nil, nil, fmt.Errorf("failed to read nodes: %v", err)
 	}
 	if nodes < 1 {
-		return nil, fmt.Errorf("invalid number of nodes: %d", nodes)
+		return nil, nil, nil, fmt.Errorf("invalid number of nodes: %d", nodes)
 	}
 
 	if err := binary.Read(file, binary.LittleEndian, &edges); err != nil {
-		return nil, fmt.Errorf("failed to read edges: %v", err)
+		return nil, nil, nil, fmt.Errorf("failed to read edges: %v", err)
 	}
 	if edges < 0 {
-		return nil, fmt.Errorf("invalid number of edges: %d", edges)
+		return nil, nil, nil, fmt.Errorf("invalid number of edges: %d", edges)
 	}
 
-	nindex := make([]int32, nodes+1)
+	nindex = make([]int32, nodes+1)
 	if err := binary.Read(file, binary.LittleEndian, nindex); err != nil {
-		return nil, fmt.Errorf("failed to read nindex: %v", err)
+		return nil, nil, nil, fmt.Errorf("failed to read nindex: %v", err)
 	}
 
 	if nindex[0] != 0 || nindex[nodes] != edges {
-		return nil, fmt.Errorf("invalid index array structure")
+		return nil, nil, nil, fmt.Errorf("invalid index array structure")
 	}
 
-	nlist := make([]int32, edges)
+	nlist = make([]int32, edges)
 	if err := binary.Read(file, binary.LittleEndian, nlist); err != nil {
-		return nil, fmt.Errorf("failed to read nlist: %v", err)
+		return nil, nil, nil, fmt.Errorf("failed to read nlist: %v", err)
 	}
 
-	var eweight []int
 	if _, err := file.Seek(0, io.SeekCurrent); err == nil {
 		eweightBuffer := make([]int32, edges)
 		if err := binary.Read(file, binary.LittleEndian, eweightBuffer); err == nil {
-			eweight = make([]int, edges)
-			for i, w := range eweightBuffer {
-				eweight[i] = int(w)
-			}
+			eweight = eweightBuffer
 		}
 	}
 	if len(eweight) > 0 && len(eweight) != int(edges) {
-		return nil, fmt.Errorf("not enought weights (edges are %d, but weights are %d)", edges, len(eweight))
+		return nil, nil, nil, fmt.Errorf("not enought weights (edges are %d, but weights are %d)", edges, len(eweight))
 	}
 
-	g := graphs.NewWeightedGraph()
-	rand := rand.New(rand.NewSource(64))
-
 	for uId := int32(0); uId < nodes; uId++ {
 		start := nindex[uId]
 		end := nindex[uId+1]
 
 		if start > end || end > edges {
-			return nil, fmt.Errorf("invalid index range for vertex %d", uId)
+			return nil, nil, nil, fmt.Errorf("invalid index range for vertex %d", uId)
 		}
 
-		u := strconv.Itoa(int(uId))
 		for _, neighborId := range nlist[start:end] {
 			if neighborId < 0 || neighborId >= nodes {
-				return nil, fmt.Errorf("invalid neighbor index %d for vertex %d", neighborId, uId)
+				return nil, nil, nil, fmt.Errorf("invalid neighbor index %d for vertex %d", neighborId, uId)
 			}
-			v := strconv.Itoa(int(neighborId))
-			weight := 0
-			if len(eweight) > 0 {
-				weight = eweight[start]
-			} else {
-				weight = rand.Int() % 1000
+		}
+	}
 
-			}
-			g.AddEdge(u, v, weight)
-			start++
+	return nindex, nlist, eweight, nil
+}
+
+func readECLgraph[N graphs.Vertex](filename string, name func(id int32) N) (*graphs.WeightedGraphOf[N, int], error) {
+	nindex, nlist, eweight, err := readECLArrays(filename)
+	if err != nil {
+		return nil, err
+	}
+	nodes := int32(len(nindex) - 1)
+
+	g := graphs.NewWeightedGraphOf[N, int]()
+	weights := arcWeights(nindex, nlist, eweight)
+	for uId := int32(0); uId < nodes; uId++ {
+		u := name(uId)
+		for i := nindex[uId]; i < nindex[uId+1]; i++ {
+			g.AddEdge(u, name(nlist[i]), weights[i])
 		}
 	}
 
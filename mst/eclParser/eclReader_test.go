@@ -0,0 +1,122 @@
+package eclParser
+
+import (
+	"encoding/binary"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+
+	mst "github.com/Salvatore112/graph_analysis_algorithms/mst/algos"
+)
+
+// writeECL stores the triangle 0-1-2 with weights 1, 2, 3 in ECL format.
+func writeECL(t *testing.T) string {
+	t.Helper()
+	return writeECLData(t, []any{
+		int32(3), int32(6),
+		[]int32{0, 2, 4, 6},
+		[]int32{1, 2, 0, 2, 0, 1},
+		[]int32{1, 3, 1, 2, 3, 2},
+	})
+}
+
+func writeECLData(t *testing.T, data []any) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "graph.egr")
+	file, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	for _, d := range data {
+		if err := binary.Write(file, binary.LittleEndian, d); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return path
+}
+
+func TestReadECLCSR(t *testing.T) {
+	g, err := ReadECLCSR(writeECL(t))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if g.VertexCount() != 3 || g.ArcCount() != 6 {
+		t.Fatalf("Expected 3 vertices and 6 arcs, got %d and %d", g.VertexCount(), g.ArcCount())
+	}
+	if w, ok := g.GetEdgeWeight("1", "2"); !ok || w != 2 {
+		t.Errorf("Expected weight 2 for edge 1-2, got %d", w)
+	}
+}
+
+func TestReadECLgraphMatchesCSR(t *testing.T) {
+	path := writeECL(t)
+	g, err := ReadECLgraph(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	indexed, err := ReadECLgraphIndexed(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if w, ok := g.GetEdgeWeight("0", "2"); !ok || w != 3 {
+		t.Errorf("Expected weight 3 for edge 0-2, got %d", w)
+	}
+	if w, ok := indexed.GetEdgeWeight(0, 2); !ok || w != 3 {
+		t.Errorf("Expected weight 3 for edge 0-2, got %d", w)
+	}
+}
+
+func TestUnweightedECLKeepsSeededWeights(t *testing.T) {
+	// The path 0-1-2 without weights; the arcs are 0->1, 1->0, 1->2, 2->1.
+	path := writeECLData(t, []any{
+		int32(3), int32(4),
+		[]int32{0, 1, 3, 4},
+		[]int32{1, 0, 2, 1},
+	})
+	g, err := ReadECLgraph(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Every arc draws a weight in file order and the later arc of an edge wins.
+	rand := rand.New(rand.NewSource(64))
+	drawn := make([]int, 4)
+	for i := range drawn {
+		drawn[i] = rand.Int() % 1000
+	}
+	if w, ok := g.GetEdgeWeight("0", "1"); !ok || w != drawn[1] {
+		t.Errorf("Expected weight %d for edge 0-1, got %d", drawn[1], w)
+	}
+	if w, ok := g.GetEdgeWeight("1", "2"); !ok || w != drawn[3] {
+		t.Errorf("Expected weight %d for edge 1-2, got %d", drawn[3], w)
+	}
+}
+
+func TestUnweightedECLLoadersAgree(t *testing.T) {
+	// K4 on 0..3 with the pendant vertex 4 attached to 3, without weights.
+	path := writeECLData(t, []any{
+		int32(5), int32(14),
+		[]int32{0, 3, 6, 9, 13, 14},
+		[]int32{1, 2, 3, 0, 2, 3, 0, 1, 3, 0, 1, 2, 4, 3},
+	})
+	csr, err := ReadECLCSR(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	g, err := ReadECLgraph(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for u, v := range g.Edges() {
+		w, _ := g.GetEdgeWeight(u, v)
+		if cw, ok := csr.GetEdgeWeight(u, v); !ok || cw != w {
+			t.Errorf("Edge %s-%s: weight %d in the graph, %d in the CSR", u, v, w, cw)
+		}
+	}
+	if a, b := mst.KruskalMST(csr).TotalWeight, mst.KruskalMST(g).TotalWeight; a != b {
+		t.Errorf("Expected equal MST weights, got %d and %d", a, b)
+	}
+}
@@ -38,16 +38,19 @@ func GetFilesInDirectory(directory string) ([]string, error) {
 	return fileNames, nil
 }
 
-func measureExecutionTime(mstAlgorithm mst.MSTAlogorithm, g *graphs.WeightedGraph) float64 {
+// measureExecutionTime times mstAlgorithm on g. The graph is converted to
+// CSR before the clock starts, so only the algorithm itself is measured.
+func measureExecutionTime(mstAlgorithm mst.MSTAlogorithm, g graphs.Weighted) float64 {
+	csr := graphs.ToCSR(g)
 	startTime := time.Now()
-	mstAlgorithm(g)
+	mstAlgorithm(csr)
 	elapsedTime := time.Since(startTime).Seconds()
 	return elapsedTime
 }
 
-func runExperiment(g *graphs.WeightedGraph, graphName string) {
-	vertexCount := len(g.Vertices)
-	edgeCount := len(g.GetEdges())
+func runExperiment(g *graphs.CSRGraph, graphName string) {
+	vertexCount := g.VertexCount()
+	edgeCount := g.ArcCount() / 2
 
 	algorithmFunctions := map[string]mst.MSTAlogorithm{
 		"PrimMST":    mst.PrimMST,
@@ -76,7 +79,7 @@ func main() {
 	fmt.Printf("Graph,Vertices,Edges,Algorithm,mean(s),s.d.\n")
 	for _, fileName := range fileNames {
 		filePath := GRAPHS_DIR + "/" + fileName
-		graph, err := eclParser.ReadECLgraph(filePath)
+		graph, err := eclParser.ReadECLCSR(filePath)
 		if err != nil {
 			log.Printf("Error reading graph from file %s: %v\n", filePath, err)
 			continue
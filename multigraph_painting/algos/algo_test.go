@@ -2,9 +2,11 @@ package multigraph_algo
 
 import (
 	"reflect"
+	"strconv"
 	"testing"
 
 	"github.com/Salvatore112/graph_analysis_algorithms/graphs"
+	"github.com/Salvatore112/graph_analysis_algorithms/graphs/generators"
 )
 
 func makeMultiGraph(pairs map[[2]string]int) *graphs.MultiGraph {
@@ -166,6 +168,54 @@ func TestVerifyEdgeColoringDetectsUncolored(t *testing.T) {
 	}
 }
 
+// TestEdgeListSelfLoops проверяет, что петля даёт одно физическое ребро
+// и добавляет 2 к степени вершины.
+func TestEdgeListSelfLoops(t *testing.T) {
+	g := graphs.NewMultiGraph()
+	g.AddEdge("a", "b")
+	g.AddEdge("a", "a")
+	g.AddEdge("b", "b")
+	g.AddEdge("b", "b")
+
+	edges := EdgeList(g)
+	expected := []Edge{{ID: 0, U: "a", V: "a"}, {ID: 1, U: "a", V: "b"}, {ID: 2, U: "b", V: "b"}, {ID: 3, U: "b", V: "b"}}
+	if !reflect.DeepEqual(edges, expected) {
+		t.Fatalf("EdgeList: ожидается %v, получили %v", expected, edges)
+	}
+	if len(edges) != g.EdgeCount() {
+		t.Fatalf("EdgeList: %d рёбер, EdgeCount = %d", len(edges), g.EdgeCount())
+	}
+	if d := Degree(g, "b"); d != 5 {
+		t.Fatalf("Degree(b) expected 5, got %d", d)
+	}
+	if mu := Mu(g); mu != 2 {
+		t.Fatalf("Mu expected 2, got %d", mu)
+	}
+}
+
+// TestEdgeListRejectsDirected проверяет, что EdgeList, MaxDegree и Mu
+// не принимают ориентированный граф, а не теряют молча дуги u→v при u > v.
+func TestEdgeListRejectsDirected(t *testing.T) {
+	g := graphs.NewDirectedGraph()
+	g.AddEdge("b", "a")
+	g.AddEdge("a", "b")
+
+	for name, f := range map[string]func(graphs.Graph){
+		"EdgeList":  func(g graphs.Graph) { EdgeList(g) },
+		"MaxDegree": func(g graphs.Graph) { MaxDegree(g) },
+		"Mu":        func(g graphs.Graph) { Mu(g) },
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s: ожидается паника на ориентированном графе", name)
+				}
+			}()
+			f(g)
+		}()
+	}
+}
+
 func TestEdgeListDeterministic(t *testing.T) {
 	g := makeMultiGraph(map[[2]string]int{
 		{"m", "n"}: 1,
@@ -250,3 +300,126 @@ func TestExactEdgeColoring_ShannonTightDoubleK3(t *testing.T) {
 		t.Fatalf("verification failed: %v", err)
 	}
 }
+
+func TestGreedyEdgeColoringOnBasicGraph(t *testing.T) {
+	g := graphs.NewBasicGraph()
+	g.AddEdge("a", "b")
+	g.AddEdge("b", "c")
+	g.AddEdge("c", "a")
+
+	if d := MaxDegree(g); d != 2 {
+		t.Fatalf("MaxDegree expected 2, got %d", d)
+	}
+	if mu := Mu(g); mu != 1 {
+		t.Fatalf("Mu expected 1 for a simple graph, got %d", mu)
+	}
+
+	_, edges, colors := GreedyEdgeColoring(g)
+	if len(edges) != 3 {
+		t.Fatalf("EdgeList: ожидается 3 ребра, получили %d", len(edges))
+	}
+	if err := VerifyEdgeColoring(edges, colors); err != nil {
+		t.Fatalf("GreedyEdgeColoring produced invalid coloring: %v", err)
+	}
+}
+
+func TestEdgeColoringKeepsGraph(t *testing.T) {
+	g := makeMultiGraph(map[[2]string]int{
+		{"a", "b"}: 2,
+		{"b", "c"}: 1,
+		{"c", "d"}: 3,
+	})
+	orig := g.Clone()
+
+	GreedyEdgeColoring(g)
+	ExactEdgeColoring(g)
+	if _, _, _, err := BipartiteEdgeColoring(g); err != nil {
+		t.Fatalf("BipartiteEdgeColoring failed: %v", err)
+	}
+
+	if !g.Equal(orig) {
+		t.Fatalf("coloring changed the graph: %+v", orig.Diff(g))
+	}
+}
+
+func TestEdgeColoringIsLineGraphColoring(t *testing.T) {
+	g := makeMultiGraph(map[[2]string]int{
+		{"a", "b"}: 2,
+		{"a", "c"}: 1,
+		{"b", "c"}: 1,
+		{"c", "d"}: 2,
+	})
+
+	_, edges, colors := GreedyEdgeColoring(g)
+	line, lineEdges := graphs.LineGraph(g)
+	if len(lineEdges) != len(edges) {
+		t.Fatalf("expected %d line graph vertices, got %d", len(edges), len(lineEdges))
+	}
+	for _, e := range edges {
+		if lineEdges[e.ID] != [2]string{e.U, e.V} {
+			t.Fatalf("edge %d is %v in EdgeList but %v in the line graph", e.ID, e, lineEdges[e.ID])
+		}
+	}
+	for u, v := range line.Edges() {
+		a, _ := strconv.Atoi(u)
+		b, _ := strconv.Atoi(v)
+		if colors[a] == colors[b] {
+			t.Fatalf("adjacent edges %v and %v share color %d", lineEdges[a], lineEdges[b], colors[a])
+		}
+	}
+}
+
+func TestExactEdgeColoring_FatTriangle(t *testing.T) {
+	for delta := 2; delta <= 6; delta++ {
+		g := generators.FatTriangle(delta).Multi()
+		k, edges, colors := ExactEdgeColoring(g)
+		if k != 3*delta/2 {
+			t.Fatalf("Δ=%d: fat triangle needs %d colors, got %d", delta, 3*delta/2, k)
+		}
+		if err := VerifyEdgeColoring(edges, colors); err != nil {
+			t.Fatalf("verification failed: %v", err)
+		}
+	}
+}
+
+// TestGreedyVsExactOnRandomMultigraphs проверяет границы Шеннона и Визинга
+// и теорему Кёнига на случайных мультиграфах с заданными Δ и μ.
+func TestGreedyVsExactOnRandomMultigraphs(t *testing.T) {
+	tests := []struct {
+		n, delta, mu int
+		bipartite    bool
+	}{
+		{5, 3, 1, false},
+		{6, 4, 2, false},
+		{5, 4, 3, false},
+		{6, 4, 2, true},
+		{8, 3, 3, true},
+	}
+	for _, tt := range tests {
+		for seed := range uint64(4) {
+			g := generators.RandomMultigraph(tt.n, tt.delta, tt.mu, tt.bipartite, seed).Multi()
+			if MaxDegree(g) != tt.delta || Mu(g) != tt.mu {
+				t.Fatalf("generator missed Δ=%d, μ=%d: got %d, %d", tt.delta, tt.mu, MaxDegree(g), Mu(g))
+			}
+
+			greedy, edges, colors := GreedyEdgeColoring(g)
+			if err := VerifyEdgeColoring(edges, colors); err != nil {
+				t.Fatalf("greedy coloring is invalid: %v", err)
+			}
+			exact, edges, colors := ExactEdgeColoring(g)
+			if err := VerifyEdgeColoring(edges, colors); err != nil {
+				t.Fatalf("exact coloring is invalid: %v", err)
+			}
+
+			if exact < tt.delta || exact > greedy {
+				t.Fatalf("%+v seed %d: expected Δ <= exact <= greedy, got %d and %d", tt, seed, exact, greedy)
+			}
+			if exact > min(3*tt.delta/2, tt.delta+tt.mu) {
+				t.Fatalf("%+v seed %d: exact %d breaks the Shannon/Vizing bounds", tt, seed, exact)
+			}
+			if tt.bipartite && exact != tt.delta {
+				t.Fatalf("%+v seed %d: bipartite multigraph needs Δ colors, got %d", tt, seed, exact)
+			}
+		}
+	}
+}
@@ -12,11 +12,11 @@ const pairSep = "\x00"
 
 // IsBipartite проверяет двудольность графа и возвращает map vertex->partition (0 или 1).
 // Если не двудольный — возвращает (nil, false).
-func IsBipartite(g *graphs.MultiGraph) (map[string]int, bool) {
+func IsBipartite(g graphs.Graph) (map[string]int, bool) {
 	part := make(map[string]int)
 	visited := make(map[string]bool)
 
-	for start := range g.Vertices {
+	for start := range g.AllVertices() {
 		if visited[start] {
 			continue
 		}
@@ -27,7 +27,7 @@ func IsBipartite(g *graphs.MultiGraph) (map[string]int, bool) {
 		for len(queue) > 0 {
 			u := queue[0]
 			queue = queue[1:]
-			for v := range g.Vertices[u] {
+			for v := range g.Neighbors(u) {
 				if u == v {
 					return nil, false
 				}
@@ -63,7 +63,7 @@ func keyOf(a, b string, part map[string]int) (string, string) {
 // Алгоритм: повторно находит максимальное паросочетание на остаче рёбер Δ раз (Δ = MaxDegree).
 // Для каждого найденного паросочетания все выбранные рёбра получают текущий цвет.
 // Возвращает (colorsUsed, edges, colorsMap, error).
-func BipartiteEdgeColoring(g *graphs.MultiGraph) (int, []Edge, map[int]int, error) {
+func BipartiteEdgeColoring(g graphs.Graph) (int, []Edge, map[int]int, error) {
 	part, ok := IsBipartite(g)
 	if !ok {
 		return 0, nil, nil, errors.New("graph is not bipartite")
@@ -1,7 +1,7 @@
 package multigraph_algo
 
 import (
-	"sort"
+	"slices"
 
 	"github.com/Salvatore112/graph_analysis_algorithms/graphs"
 )
@@ -13,28 +13,21 @@ type Edge struct {
 	V  string
 }
 
-// EdgeList — разворачивает кратности из graphs.MultiGraph в список физических рёбер.
+// EdgeList — разворачивает кратности графа в список физических рёбер.
 // Генерирует детерминированный порядок: сортировка вершин, затем для каждой пары (u<=v)
-// создаёт cnt экземпляров.
-func EdgeList(g *graphs.MultiGraph) []Edge {
+// создаёт cnt экземпляров. Граф должен быть неориентированным, иначе EdgeList паникует.
+func EdgeList(g graphs.Graph) []Edge {
+	mustBeUndirected(g)
 	edges := make([]Edge, 0)
-	verts := make([]string, 0, len(g.Vertices))
-	for v := range g.Vertices {
-		verts = append(verts, v)
-	}
-	sort.Strings(verts)
+	verts := g.SortedVertices()
 
 	id := 0
 	for _, u := range verts {
-		neigh := g.Vertices[u]
-		if neigh == nil {
-			continue
-		}
-		for v, cnt := range neigh {
+		for _, v := range slices.Sorted(g.Neighbors(u)) {
 			if u > v {
 				continue
 			}
-			for i := 0; i < cnt; i++ {
+			for i := 0; i < multiplicity(g, u, v); i++ {
 				edges = append(edges, Edge{ID: id, U: u, V: v})
 				id++
 			}
@@ -43,21 +36,35 @@ func EdgeList(g *graphs.MultiGraph) []Edge {
 	return edges
 }
 
-// Degree считает степень (с учётом кратностей) вершины v.
-func Degree(g *graphs.MultiGraph, v string) int {
-	if g.Vertices[v] == nil {
-		return 0
+// mustBeUndirected паникует на ориентированном графе: раскраска рёбер определена
+// только для неориентированных (мульти)графов, а дуги u→v и v→u нельзя свести к паре u<=v.
+func mustBeUndirected(g graphs.Graph) {
+	if g.Directed() {
+		panic("multigraph_algo: edge coloring needs an undirected graph")
+	}
+}
+
+// multiplicity возвращает число параллельных рёбер u–v; для простых графов это 0 или 1.
+func multiplicity(g graphs.Graph, u, v string) int {
+	if mg, ok := g.(graphs.Multi); ok {
+		return mg.Multiplicity(u, v)
 	}
-	sum := 0
-	for _, c := range g.Vertices[v] {
-		sum += c
+	if g.HasEdge(u, v) {
+		return 1
 	}
-	return sum
+	return 0
 }
 
-func MaxDegree(g *graphs.MultiGraph) int {
+// Degree считает степень (с учётом кратностей) вершины v.
+func Degree(g graphs.Graph, v string) int {
+	return g.Degree(v)
+}
+
+// MaxDegree возвращает Δ — максимальную степень вершины неориентированного графа.
+func MaxDegree(g graphs.Graph) int {
+	mustBeUndirected(g)
 	_max := 0
-	for v := range g.Vertices {
+	for v := range g.AllVertices() {
 		if d := Degree(g, v); d > _max {
 			_max = d
 		}
@@ -65,21 +72,15 @@ func MaxDegree(g *graphs.MultiGraph) int {
 	return _max
 }
 
-func Mu(g *graphs.MultiGraph) int {
+// Mu возвращает μ — максимальную кратность ребра неориентированного графа.
+func Mu(g graphs.Graph) int {
+	mustBeUndirected(g)
 	m := 0
-	seen := make(map[string]map[string]bool)
-	for u, neigh := range g.Vertices {
-		if seen[u] == nil {
-			seen[u] = make(map[string]bool)
-		}
-		for v, cnt := range neigh {
-			if seen[v] != nil && seen[v][u] {
-				continue
-			}
-			if cnt > m {
+	for u := range g.AllVertices() {
+		for v := range g.Neighbors(u) {
+			if cnt := multiplicity(g, u, v); cnt > m {
 				m = cnt
 			}
-			seen[u][v] = true
 		}
 	}
 	return m
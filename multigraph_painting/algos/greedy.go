@@ -7,7 +7,7 @@ import (
 )
 
 // GreedyEdgeColoring возвращает (colorsUsed, edges, colorsMap)
-func GreedyEdgeColoring(g *graphs.MultiGraph) (int, []Edge, map[int]int) {
+func GreedyEdgeColoring(g graphs.Graph) (int, []Edge, map[int]int) {
 	edges := EdgeList(g)
 
 	inc := make(map[string][]int)
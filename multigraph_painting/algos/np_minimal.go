@@ -11,7 +11,7 @@ import (
 // Возвращает (colorsUsed, edges, colorsMap).
 // Внутри: итеративный поиск по k = Δ..UB, где UB берётся из жадной раскраски (если она есть)
 // или из оценки Шеннона (⌊3Δ/2⌋) как запасной вариант.
-func ExactEdgeColoring(g *graphs.MultiGraph) (int, []Edge, map[int]int) {
+func ExactEdgeColoring(g graphs.Graph) (int, []Edge, map[int]int) {
 	edges := EdgeList(g)
 	if len(edges) == 0 {
 		return 0, edges, map[int]int{}
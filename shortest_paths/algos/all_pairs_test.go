@@ -0,0 +1,104 @@
+package shortest_paths
+
+import (
+	"errors"
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/Salvatore112/graph_analysis_algorithms/graphs"
+	"github.com/Salvatore112/graph_analysis_algorithms/graphs/generators"
+)
+
+type allPairs func(g graphs.Weighted) (*DistanceMatrix, error)
+
+var allPairsAlgorithms = map[string]allPairs{"FloydWarshall": FloydWarshall, "Johnson": Johnson}
+
+func TestAllPairsNegativeWeights(t *testing.T) {
+	g := orientedGraph([]graphs.WeightedEdge{
+		{U: "A", V: "B", Weight: 3}, {U: "A", V: "C", Weight: 8}, {U: "B", V: "C", Weight: -2},
+		{U: "C", V: "D", Weight: 1}, {U: "D", V: "A", Weight: 2}, {U: "B", V: "D", Weight: 7},
+	})
+	g.AddVertex("E")
+	for name, algo := range allPairsAlgorithms {
+		t.Run(name, func(t *testing.T) {
+			m, err := algo(g)
+			assert.NilError(t, err)
+			assert.DeepEqual(t, []string{"A", "B", "C", "D", "E"}, m.Vertices())
+
+			d, ok := m.Dist("A", "D")
+			assert.Assert(t, ok)
+			assert.Equal(t, 2, d)
+			assert.DeepEqual(t, []string{"A", "B", "C", "D"}, m.Path("A", "D"))
+			d, _ = m.Dist("C", "B")
+			assert.Equal(t, 6, d)
+			assert.DeepEqual(t, []string{"C", "D", "A", "B"}, m.Path("C", "B"))
+
+			_, ok = m.Dist("A", "E")
+			assert.Assert(t, !ok)
+			assert.Assert(t, m.Path("E", "A") == nil)
+			assert.DeepEqual(t, []string{"E"}, m.Path("E", "E"))
+			_, ok = m.Dist("A", "X")
+			assert.Assert(t, !ok)
+
+			from := m.From("B")
+			assert.DeepEqual(t, map[string]int{"A": 1, "B": 0, "C": -2, "D": -1}, from.Dist)
+			assert.DeepEqual(t, m.Path("B", "A"), from.PathTo("A"))
+		})
+	}
+}
+
+func TestAllPairsMatchBellmanFord(t *testing.T) {
+	for seed := range uint64(3) {
+		l := generators.GNM(40, 120, seed)
+		g := graphs.NewWeightedOrientedGraph()
+		weights := generators.UniformWeights(0, 20)
+		for i, e := range l.Weighted(weights, seed).SortedEdges() {
+			// Orient edges from the smaller index so that there are no
+			// cycles, which makes negative weights safe.
+			u, v := e.U, e.V
+			if len(u) > len(v) || (len(u) == len(v) && u > v) {
+				u, v = v, u
+			}
+			g.AddEdge(u, v, e.Weight-5*(i%3))
+		}
+
+		for name, algo := range allPairsAlgorithms {
+			m, err := algo(g)
+			assert.NilError(t, err, name)
+			for _, u := range m.Vertices() {
+				expected, err := BellmanFord(g, u)
+				assert.NilError(t, err)
+				assert.DeepEqual(t, expected.Dist, m.From(u).Dist)
+			}
+		}
+	}
+}
+
+func TestAllPairsNegativeCycle(t *testing.T) {
+	g := orientedGraph([]graphs.WeightedEdge{
+		{U: "A", V: "B", Weight: 1}, {U: "B", V: "C", Weight: -2}, {U: "C", V: "B", Weight: 1}, {U: "C", V: "D", Weight: 1},
+	})
+	for name, algo := range allPairsAlgorithms {
+		_, err := algo(g)
+		var cycleErr *NegativeCycleError
+		assert.Assert(t, errors.As(err, &cycleErr), name)
+		assert.Equal(t, 2, len(cycleErr.Cycle))
+	}
+
+	loop := orientedGraph([]graphs.WeightedEdge{{U: "A", V: "A", Weight: -1}, {U: "A", V: "B", Weight: 1}})
+	for name, algo := range allPairsAlgorithms {
+		_, err := algo(loop)
+		var cycleErr *NegativeCycleError
+		assert.Assert(t, errors.As(err, &cycleErr), name)
+		assert.DeepEqual(t, []string{"A"}, cycleErr.Cycle)
+	}
+}
+
+func TestAllPairsEmpty(t *testing.T) {
+	for _, algo := range allPairsAlgorithms {
+		m, err := algo(graphs.NewWeightedOrientedGraph())
+		assert.NilError(t, err)
+		assert.Equal(t, 0, len(m.Vertices()))
+	}
+}
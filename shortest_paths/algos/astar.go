@@ -0,0 +1,55 @@
+package shortest_paths
+
+import (
+	mst "github.com/Salvatore112/graph_analysis_algorithms/mst/algos"
+
+	"github.com/Salvatore112/graph_analysis_algorithms/graphs"
+)
+
+// AStar finds a shortest path from source to target in a graph with
+// non-negative weights, exploring vertices in the order of their distance
+// from source plus the estimate h of their distance to target.
+//
+// With an admissible h the result is a shortest path. If h is also
+// consistent (h(u) <= w(u, v) + h(v) for every arc) every vertex is settled
+// at most once. Otherwise a settled vertex is reopened, that is put back into
+// the queue, whenever a shorter path to it is found, and Route.Settled counts
+// every time it is settled. h is called once per reached vertex.
+func AStar(g graphs.Weighted, source, target string, h Heuristic) (*Route, error) {
+	s, src, dst, err := endpoints(g, source, target)
+	if err != nil {
+		return nil, err
+	}
+
+	estimate := make([]int, len(s.dist))
+	estimate[src] = h(source)
+	q := mst.NewPQ[int32, int]()
+	q.Push(mst.Node[int32, int]{Value: src, Priority: estimate[src]})
+	res := &Route{}
+	for !q.IsEmpty() {
+		u := q.Pop().(mst.Node[int32, int]).Value
+		res.Settled++
+		if u == dst {
+			res.Path, res.Cost = s.pathTo(dst), s.dist[dst]
+			return res, nil
+		}
+		lo, hi := s.g.ArcRange(u)
+		for i := lo; i < hi; i++ {
+			v := s.g.Target(i)
+			d := s.dist[u] + s.g.ArcWeight(i)
+			if d >= s.dist[v] {
+				continue
+			}
+			if s.dist[v] == unreachable {
+				estimate[v] = h(s.g.Name(v))
+			}
+			s.dist[v], s.pred[v] = d, u
+			if q.Contains(v) {
+				q.Update(v, d+estimate[v])
+			} else {
+				q.Push(mst.Node[int32, int]{Value: v, Priority: d + estimate[v]})
+			}
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,113 @@
+package shortest_paths
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/Salvatore112/graph_analysis_algorithms/graphs"
+)
+
+// ShortestPaths is the result of a single-source search: the distances of
+// the reachable vertices and the tree of shortest paths leading to them.
+//
+// The algorithms work on both directed and undirected graphs; an
+// undirected edge may be used in either direction.
+type ShortestPaths struct {
+	Source string
+	// Dist holds the distance from Source to every reachable vertex.
+	Dist map[string]int
+	// Pred holds the previous vertex on the shortest path to every
+	// reachable vertex except Source.
+	Pred map[string]string
+}
+
+// Reachable reports whether there is a path from Source to v.
+func (p *ShortestPaths) Reachable(v string) bool {
+	_, ok := p.Dist[v]
+	return ok
+}
+
+// PathTo returns the vertices of the shortest path from Source to v, both
+// included, or nil if v is unreachable.
+func (p *ShortestPaths) PathTo(v string) []string {
+	if !p.Reachable(v) {
+		return nil
+	}
+	path := []string{v}
+	for v != p.Source {
+		v = p.Pred[v]
+		path = append(path, v)
+	}
+	slices.Reverse(path)
+	return path
+}
+
+// NegativeCycleError reports a cycle of negative total weight reachable
+// from the source: an arc leads from every vertex of Cycle to the next one
+// and from the last one to the first.
+type NegativeCycleError struct {
+	Cycle []string
+}
+
+func (e *NegativeCycleError) Error() string {
+	return fmt.Sprintf("negative cycle: %s -> %s", strings.Join(e.Cycle, " -> "), e.Cycle[0])
+}
+
+const unreachable = math.MaxInt
+const noPred = -1
+
+// search holds the state of a search over a CSR graph.
+type search struct {
+	g    *graphs.CSRGraph
+	dist []int
+	pred []int32
+}
+
+// newSearch converts g and sets up distances for a search from source.
+func newSearch(g graphs.Weighted, source string) (*search, int32, error) {
+	csr := graphs.ToCSR(g)
+	s, ok := csr.ID(source)
+	if !ok {
+		return nil, 0, fmt.Errorf("source vertex %q not found", source)
+	}
+	return newCSRSearch(csr, s), s, nil
+}
+
+func newCSRSearch(g *graphs.CSRGraph, source int32) *search {
+	n := g.VertexCount()
+	res := &search{g, make([]int, n), make([]int32, n)}
+	for v := range n {
+		res.dist[v], res.pred[v] = unreachable, noPred
+	}
+	res.dist[source] = 0
+	return res
+}
+
+// checkWeights returns an error for the first arc whose weight ok rejects.
+func (s *search) checkWeights(ok func(w int) bool, what string) error {
+	for u := range int32(s.g.VertexCount()) {
+		lo, hi := s.g.ArcRange(u)
+		for i := lo; i < hi; i++ {
+			if w := s.g.ArcWeight(i); !ok(w) {
+				return fmt.Errorf("edge %s-%s has weight %d, %s", s.g.Name(u), s.g.Name(s.g.Target(i)), w, what)
+			}
+		}
+	}
+	return nil
+}
+
+func (s *search) result(source int32) *ShortestPaths {
+	res := &ShortestPaths{Source: s.g.Name(source), Dist: make(map[string]int), Pred: make(map[string]string)}
+	for v, d := range s.dist {
+		if d == unreachable {
+			continue
+		}
+		res.Dist[s.g.Name(int32(v))] = d
+		if p := s.pred[v]; p != noPred {
+			res.Pred[s.g.Name(int32(v))] = s.g.Name(p)
+		}
+	}
+	return res
+}
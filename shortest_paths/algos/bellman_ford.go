@@ -0,0 +1,79 @@
+package shortest_paths
+
+import (
+	"slices"
+
+	"github.com/Salvatore112/graph_analysis_algorithms/graphs"
+)
+
+// BellmanFord finds shortest paths from source in a graph that may have
+// negative weights. If a cycle of negative weight is reachable from source,
+// it returns a *NegativeCycleError with that cycle. In an undirected graph
+// every negative edge forms such a cycle with itself.
+//
+// The algorithm makes at most V-1 passes over all arcs and stops early once
+// a pass changes nothing, so it runs in O(VE).
+func BellmanFord(g graphs.Weighted, source string) (*ShortestPaths, error) {
+	s, src, err := newSearch(g, source)
+	if err != nil {
+		return nil, err
+	}
+	if cycle := s.bellmanFord(s.g.VertexCount()); cycle != nil {
+		return nil, &NegativeCycleError{cycle}
+	}
+	return s.result(src), nil
+}
+
+// bellmanFord relaxes all arcs until nothing changes, for a graph of n
+// vertices, and returns a negative cycle if the n-th pass still relaxes an
+// arc.
+func (s *search) bellmanFord(n int) []string {
+	for pass := range n {
+		relaxed := s.relaxAll()
+		if relaxed == noPred {
+			break
+		}
+		if pass == n-1 {
+			return s.negativeCycle(relaxed, n)
+		}
+	}
+	return nil
+}
+
+// relaxAll relaxes every arc leaving a reachable vertex and returns the
+// last vertex whose distance decreased, or noPred.
+func (s *search) relaxAll() int32 {
+	relaxed := int32(noPred)
+	for u := range int32(s.g.VertexCount()) {
+		if s.dist[u] == unreachable {
+			continue
+		}
+		lo, hi := s.g.ArcRange(u)
+		for i := lo; i < hi; i++ {
+			v := s.g.Target(i)
+			if d := s.dist[u] + s.g.ArcWeight(i); d < s.dist[v] {
+				s.dist[v], s.pred[v] = d, u
+				relaxed = v
+			}
+		}
+	}
+	return relaxed
+}
+
+// negativeCycle follows predecessors from a vertex relaxed in the n-th pass.
+// After n steps the walk is inside a negative cycle, which it then traces.
+func (s *search) negativeCycle(v int32, n int) []string {
+	for range n {
+		v = s.pred[v]
+	}
+	cycle := []int32{v}
+	for u := s.pred[v]; u != v; u = s.pred[u] {
+		cycle = append(cycle, u)
+	}
+	slices.Reverse(cycle)
+	res := make([]string, len(cycle))
+	for i, u := range cycle {
+		res[i] = s.g.Name(u)
+	}
+	return res
+}
@@ -0,0 +1,121 @@
+package shortest_paths
+
+import (
+	"slices"
+
+	mst "github.com/Salvatore112/graph_analysis_algorithms/mst/algos"
+
+	"github.com/Salvatore112/graph_analysis_algorithms/graphs"
+)
+
+// BidirectionalDijkstra finds a shortest path from source to target in a
+// graph with non-negative weights by running Dijkstra's algorithm forward
+// from source and backward from target at the same time.
+//
+// The side with the smaller queue head advances; the search stops once the
+// two heads together reach the best path found through an arc between the
+// searched regions. On large graphs this settles far fewer vertices than a
+// one-sided search.
+func BidirectionalDijkstra(g graphs.Weighted, source, target string) (*Route, error) {
+	forward, src, dst, err := endpoints(g, source, target)
+	if err != nil {
+		return nil, err
+	}
+	backward := newCSRSearch(forward.g, dst)
+	sides := [2]*direction{
+		{search: forward, arcs: forward.g},
+		{search: backward, arcs: reverseArcs(forward.g)},
+	}
+	for i, start := range []int32{src, dst} {
+		sides[i].q = mst.NewPQ[int32, int]()
+		sides[i].q.Push(mst.Node[int32, int]{Value: start, Priority: 0})
+		sides[i].done = make([]bool, len(forward.dist))
+	}
+
+	res := &Route{}
+	best, meet := unreachable, int32(noPred)
+	if src == dst {
+		best, meet = 0, src
+	}
+	for !sides[0].q.IsEmpty() && !sides[1].q.IsEmpty() {
+		top0, top1 := sides[0].q.Peek().Priority, sides[1].q.Peek().Priority
+		if best != unreachable && top0+top1 >= best {
+			break
+		}
+		i := 0
+		if top1 < top0 {
+			i = 1
+		}
+		side, other := sides[i], sides[1-i]
+		u := side.q.Pop().(mst.Node[int32, int]).Value
+		side.done[u] = true
+		res.Settled++
+		lo, hi := side.arcs.ArcRange(u)
+		for j := lo; j < hi; j++ {
+			v := side.arcs.Target(j)
+			d := side.dist[u] + side.arcs.ArcWeight(j)
+			if d < side.dist[v] && !side.done[v] {
+				side.dist[v], side.pred[v] = d, u
+				if side.q.Contains(v) {
+					side.q.Update(v, d)
+				} else {
+					side.q.Push(mst.Node[int32, int]{Value: v, Priority: d})
+				}
+			}
+			if od := other.dist[v]; od != unreachable && d+od < best {
+				best, meet = d+od, v
+			}
+		}
+	}
+
+	if meet == noPred {
+		return res, nil
+	}
+	// The backward predecessors lead from meet towards target.
+	path := forward.pathTo(meet)
+	tail := backward.pathTo(meet)
+	slices.Reverse(tail)
+	res.Path, res.Cost = append(path, tail[1:]...), best
+	return res, nil
+}
+
+// direction is one side of a bidirectional search; arcs holds the arcs it
+// follows, which for the backward side are the reversed arcs of the graph.
+type direction struct {
+	*search
+	arcs *graphs.CSRGraph
+	q    *mst.PriorityQueue[int32, int]
+	done []bool
+}
+
+// reverseArcs returns a graph with every arc of g reversed; undirected
+// graphs are their own reverse.
+func reverseArcs(g *graphs.CSRGraph) *graphs.CSRGraph {
+	if !g.Directed() {
+		return g
+	}
+	n := g.VertexCount()
+	index := make([]int32, n+1)
+	for i := range g.ArcCount() {
+		index[g.Target(i)+1]++
+	}
+	for v := range n {
+		index[v+1] += index[v]
+	}
+	next := slices.Clone(index[:n])
+	targets := make([]int32, g.ArcCount())
+	weights := make([]int, g.ArcCount())
+	for u := range int32(n) {
+		lo, hi := g.ArcRange(u)
+		for i := lo; i < hi; i++ {
+			v := g.Target(i)
+			targets[next[v]], weights[next[v]] = u, g.ArcWeight(i)
+			next[v]++
+		}
+	}
+	reversed, err := graphs.NewCSRGraph(index, targets, weights, true)
+	if err != nil {
+		panic(err)
+	}
+	return reversed
+}
@@ -0,0 +1,48 @@
+package shortest_paths
+
+import (
+	mst "github.com/Salvatore112/graph_analysis_algorithms/mst/algos"
+
+	"github.com/Salvatore112/graph_analysis_algorithms/graphs"
+)
+
+// Dijkstra finds shortest paths from source in a graph with non-negative
+// weights. It uses the decrease-key priority queue of the mst package and
+// runs in O((V + E) log V).
+func Dijkstra(g graphs.Weighted, source string) (*ShortestPaths, error) {
+	s, src, err := newSearch(g, source)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkWeights(func(w int) bool { return w >= 0 }, "Dijkstra needs non-negative weights"); err != nil {
+		return nil, err
+	}
+	s.dijkstra(src, func(_ int32, i int) int { return s.g.ArcWeight(i) })
+	return s.result(src), nil
+}
+
+// dijkstra runs the search from src with the weight of the arc at position
+// i leaving u given by weight, which must be non-negative.
+func (s *search) dijkstra(src int32, weight func(u int32, i int) int) {
+	done := make([]bool, len(s.dist))
+	q := mst.NewPQ[int32, int]()
+	q.Push(mst.Node[int32, int]{Value: src, Priority: 0})
+	for !q.IsEmpty() {
+		u := q.Pop().(mst.Node[int32, int]).Value
+		done[u] = true
+		lo, hi := s.g.ArcRange(u)
+		for i := lo; i < hi; i++ {
+			v := s.g.Target(i)
+			d := s.dist[u] + weight(u, i)
+			if done[v] || d >= s.dist[v] {
+				continue
+			}
+			s.dist[v], s.pred[v] = d, u
+			if q.Contains(v) {
+				q.Update(v, d)
+			} else {
+				q.Push(mst.Node[int32, int]{Value: v, Priority: d})
+			}
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package shortest_paths
+
+import (
+	"slices"
+
+	"github.com/Salvatore112/graph_analysis_algorithms/graphs"
+)
+
+// DistanceMatrix holds the shortest path distances between all pairs of
+// vertices, indexed by vertex names.
+type DistanceMatrix struct {
+	g    *graphs.CSRGraph
+	dist [][]int
+	// pred[u][v] is the vertex before v on the shortest path from u to v.
+	pred [][]int32
+}
+
+func newDistanceMatrix(g *graphs.CSRGraph) *DistanceMatrix {
+	n := g.VertexCount()
+	m := &DistanceMatrix{g, make([][]int, n), make([][]int32, n)}
+	for u := range n {
+		m.dist[u], m.pred[u] = make([]int, n), make([]int32, n)
+		for v := range n {
+			m.dist[u][v], m.pred[u][v] = unreachable, noPred
+		}
+		m.dist[u][u] = 0
+	}
+	return m
+}
+
+// Vertices returns the vertices of the matrix in sorted order.
+func (m *DistanceMatrix) Vertices() []string {
+	return m.g.SortedVertices()
+}
+
+func (m *DistanceMatrix) ids(u, v string) (int32, int32, bool) {
+	i, ok1 := m.g.ID(u)
+	j, ok2 := m.g.ID(v)
+	return i, j, ok1 && ok2
+}
+
+// Dist returns the distance from u to v and whether v is reachable from u.
+func (m *DistanceMatrix) Dist(u, v string) (int, bool) {
+	i, j, ok := m.ids(u, v)
+	if !ok || m.dist[i][j] == unreachable {
+		return 0, false
+	}
+	return m.dist[i][j], true
+}
+
+// Path returns the vertices of a shortest path from u to v, both included,
+// or nil if v is unreachable from u.
+func (m *DistanceMatrix) Path(u, v string) []string {
+	i, j, ok := m.ids(u, v)
+	if !ok || m.dist[i][j] == unreachable {
+		return nil
+	}
+	path := []string{v}
+	for ; j != i; j = m.pred[i][j] {
+		path = append(path, m.g.Name(m.pred[i][j]))
+	}
+	slices.Reverse(path)
+	return path
+}
+
+// From returns the shortest paths from u, or nil if u is not a vertex.
+func (m *DistanceMatrix) From(u string) *ShortestPaths {
+	i, ok := m.g.ID(u)
+	if !ok {
+		return nil
+	}
+	s := &search{m.g, m.dist[i], m.pred[i]}
+	return s.result(i)
+}
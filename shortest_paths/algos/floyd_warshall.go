@@ -0,0 +1,48 @@
+package shortest_paths
+
+import "github.com/Salvatore112/graph_analysis_algorithms/graphs"
+
+// FloydWarshall finds the shortest paths between all pairs of vertices in
+// O(V³) time and O(V²) memory, which suits small dense graphs. Weights may
+// be negative; if g has a negative cycle, a *NegativeCycleError with one of
+// them is returned.
+func FloydWarshall(g graphs.Weighted) (*DistanceMatrix, error) {
+	csr := graphs.ToCSR(g)
+	m := newDistanceMatrix(csr)
+	n := csr.VertexCount()
+	for u := range int32(n) {
+		lo, hi := csr.ArcRange(u)
+		for i := lo; i < hi; i++ {
+			v, w := csr.Target(i), csr.ArcWeight(i)
+			if u == v && w >= 0 {
+				continue
+			}
+			if w < m.dist[u][v] {
+				m.dist[u][v], m.pred[u][v] = w, u
+			}
+		}
+	}
+
+	for k := range n {
+		for i := range n {
+			dik := m.dist[i][k]
+			if dik == unreachable {
+				continue
+			}
+			for j := range n {
+				if dkj := m.dist[k][j]; dkj != unreachable && dik+dkj < m.dist[i][j] {
+					m.dist[i][j], m.pred[i][j] = dik+dkj, m.pred[k][j]
+				}
+			}
+		}
+	}
+
+	// A vertex on a negative cycle ends up with a negative distance to
+	// itself; Bellman–Ford from it recovers the cycle.
+	for u := range int32(n) {
+		if m.dist[u][u] < 0 {
+			return nil, &NegativeCycleError{newCSRSearch(csr, u).bellmanFord(n)}
+		}
+	}
+	return m, nil
+}
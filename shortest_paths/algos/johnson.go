@@ -0,0 +1,42 @@
+package shortest_paths
+
+import "github.com/Salvatore112/graph_analysis_algorithms/graphs"
+
+// Johnson finds the shortest paths between all pairs of vertices of a
+// sparse graph in O(VE log V) time. Weights may be negative; if g has a
+// negative cycle, a *NegativeCycleError with one of them is returned.
+//
+// Bellman–Ford from a virtual vertex joined to all vertices by arcs of
+// weight 0 gives potentials h, and Dijkstra then runs from every vertex on
+// the non-negative weights w(u, v) + h(u) - h(v).
+func Johnson(g graphs.Weighted) (*DistanceMatrix, error) {
+	csr := graphs.ToCSR(g)
+	n := csr.VertexCount()
+	m := newDistanceMatrix(csr)
+	if n == 0 {
+		return m, nil
+	}
+
+	// Distances from the virtual vertex start at 0 everywhere; with it the
+	// graph has n+1 vertices.
+	potentials := newCSRSearch(csr, 0)
+	clear(potentials.dist)
+	if cycle := potentials.bellmanFord(n + 1); cycle != nil {
+		return nil, &NegativeCycleError{cycle}
+	}
+	h := potentials.dist
+	reweighted := func(u int32, i int) int {
+		return csr.ArcWeight(i) + h[u] - h[csr.Target(i)]
+	}
+
+	for u := range int32(n) {
+		s := &search{csr, m.dist[u], m.pred[u]}
+		s.dijkstra(u, reweighted)
+		for v, d := range s.dist {
+			if d != unreachable {
+				s.dist[v] = d - h[u] + h[v]
+			}
+		}
+	}
+	return m, nil
+}
@@ -0,0 +1,115 @@
+package shortest_paths
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/Salvatore112/graph_analysis_algorithms/graphs"
+	"github.com/Salvatore112/graph_analysis_algorithms/graphs/generators"
+)
+
+// checkRoute checks that r is a path of g from source whose length is the
+// distance found by Dijkstra.
+func checkRoute(t *testing.T, g graphs.Weighted, r *Route, expected *ShortestPaths, target string) {
+	t.Helper()
+	if !expected.Reachable(target) {
+		assert.Assert(t, r.Path == nil)
+		return
+	}
+	assert.Equal(t, expected.Dist[target], r.Cost)
+	assert.Equal(t, expected.Source, r.Path[0])
+	assert.Equal(t, target, r.Path[len(r.Path)-1])
+	length := 0
+	for i := 1; i < len(r.Path); i++ {
+		w, ok := g.GetEdgeWeight(r.Path[i-1], r.Path[i])
+		assert.Assert(t, ok)
+		length += w
+	}
+	assert.Equal(t, r.Cost, length)
+}
+
+func TestPointToPointOnGeometricGraph(t *testing.T) {
+	l := generators.RandomGeometric(400, 0.1, 7)
+	g := l.Weighted(generators.DistanceWeights(l.Positions, 1000), 0)
+	expected, err := Dijkstra(g, "0")
+	assert.NilError(t, err)
+
+	for _, target := range []string{"399", "200", "17", "0"} {
+		euclid := EuclideanHeuristic(g.Attrs(), generators.PositionKey, target, 1000)
+		astar, err := AStar(g, "0", target, euclid)
+		assert.NilError(t, err)
+		checkRoute(t, g, astar, expected, target)
+
+		blind, err := AStar(g, "0", target, ZeroHeuristic)
+		assert.NilError(t, err)
+		checkRoute(t, g, blind, expected, target)
+		assert.Assert(t, astar.Settled <= blind.Settled, "A* settled %d, Dijkstra %d", astar.Settled, blind.Settled)
+
+		bidir, err := BidirectionalDijkstra(g, "0", target)
+		assert.NilError(t, err)
+		checkRoute(t, g, bidir, expected, target)
+	}
+}
+
+func TestPointToPointDirected(t *testing.T) {
+	for seed := range uint64(5) {
+		g := graphs.NewWeightedOrientedGraph()
+		weighted := generators.GNM(50, 150, seed).Weighted(generators.UniformWeights(1, 9), seed)
+		for i, e := range weighted.SortedEdges() {
+			if i%2 == 0 {
+				g.AddEdge(e.U, e.V, e.Weight)
+			} else {
+				g.AddEdge(e.V, e.U, e.Weight)
+			}
+		}
+		expected, err := Dijkstra(g, "3")
+		assert.NilError(t, err)
+		for _, target := range g.SortedVertices() {
+			bidir, err := BidirectionalDijkstra(g, "3", target)
+			assert.NilError(t, err)
+			checkRoute(t, g, bidir, expected, target)
+
+			astar, err := AStar(g, "3", target, ZeroHeuristic)
+			assert.NilError(t, err)
+			checkRoute(t, g, astar, expected, target)
+		}
+	}
+}
+
+func TestAStarInconsistentHeuristic(t *testing.T) {
+	g := orientedGraph([]graphs.WeightedEdge{
+		{U: "S", V: "A", Weight: 1}, {U: "S", V: "B", Weight: 1}, {U: "A", V: "C", Weight: 1},
+		{U: "B", V: "C", Weight: 3}, {U: "C", V: "T", Weight: 3},
+	})
+	// Admissible but not consistent: h(A) > w(A, C) + h(C).
+	h := map[string]int{"S": 0, "A": 4, "B": 0, "C": 0, "T": 0}
+	r, err := AStar(g, "S", "T", func(v string) int { return h[v] })
+	assert.NilError(t, err)
+	assert.DeepEqual(t, []string{"S", "A", "C", "T"}, r.Path)
+	assert.Equal(t, 5, r.Cost)
+	// C is settled through B first and reopened once A is settled:
+	// S, B, C, A, C, T.
+	assert.Equal(t, 6, r.Settled)
+}
+
+func TestPointToPointUnreachable(t *testing.T) {
+	g := orientedGraph([]graphs.WeightedEdge{{U: "A", V: "B", Weight: 1}, {U: "C", V: "B", Weight: 1}})
+	for _, find := range []func() (*Route, error){
+		func() (*Route, error) { return AStar(g, "A", "C", ZeroHeuristic) },
+		func() (*Route, error) { return BidirectionalDijkstra(g, "A", "C") },
+	} {
+		r, err := find()
+		assert.NilError(t, err)
+		assert.Assert(t, r.Path == nil)
+	}
+
+	r, err := BidirectionalDijkstra(g, "A", "A")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, []string{"A"}, r.Path)
+
+	_, err = AStar(g, "A", "X", ZeroHeuristic)
+	assert.ErrorContains(t, err, "target vertex")
+	_, err = BidirectionalDijkstra(orientedGraph([]graphs.WeightedEdge{{U: "A", V: "B", Weight: -1}}), "A", "B")
+	assert.ErrorContains(t, err, "non-negative")
+}
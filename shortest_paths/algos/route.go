@@ -0,0 +1,73 @@
+package shortest_paths
+
+import (
+	"fmt"
+	"math"
+	"slices"
+
+	"github.com/Salvatore112/graph_analysis_algorithms/graphs"
+)
+
+// Route is the result of a point-to-point query.
+type Route struct {
+	// Path lists the vertices of a shortest path from the source to the
+	// target, both included, or is nil if the target is unreachable.
+	Path []string
+	// Cost is the length of Path.
+	Cost int
+	// Settled counts the vertices taken from the priority queues, the usual
+	// measure of how much of the graph a search explored.
+	Settled int
+}
+
+// Heuristic estimates the distance from vertex v to the target of a search.
+// A* returns shortest paths if the estimate never exceeds the real distance.
+type Heuristic func(v string) int
+
+// ZeroHeuristic turns A* into Dijkstra's algorithm stopped at the target.
+func ZeroHeuristic(string) int {
+	return 0
+}
+
+// EuclideanHeuristic estimates distances by the straight-line distance
+// between vertex coordinates stored under key, multiplied by scale and
+// rounded down. It is admissible when no edge weighs less than scale times
+// the distance between its endpoints, e.g. for generators.DistanceWeights
+// with the same scale. Vertices without coordinates get the estimate 0.
+func EuclideanHeuristic(attrs *graphs.Attributes, key graphs.Key[[2]float64], target string, scale float64) Heuristic {
+	goal, ok := graphs.VertexAttr(attrs, target, key)
+	return func(v string) int {
+		p, found := graphs.VertexAttr(attrs, v, key)
+		if !ok || !found {
+			return 0
+		}
+		return int(math.Floor(math.Hypot(p[0]-goal[0], p[1]-goal[1]) * scale))
+	}
+}
+
+// endpoints converts g and looks up the ends of a point-to-point query.
+func endpoints(g graphs.Weighted, source, target string) (*search, int32, int32, error) {
+	s, src, err := newSearch(g, source)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	dst, ok := s.g.ID(target)
+	if !ok {
+		return nil, 0, 0, fmt.Errorf("target vertex %q not found", target)
+	}
+	if err := s.checkWeights(func(w int) bool { return w >= 0 }, "point-to-point search needs non-negative weights"); err != nil {
+		return nil, 0, 0, err
+	}
+	return s, src, dst, nil
+}
+
+// pathTo follows the predecessors from v back to the start of the search.
+func (s *search) pathTo(v int32) []string {
+	path := []string{s.g.Name(v)}
+	for s.pred[v] != noPred {
+		v = s.pred[v]
+		path = append(path, s.g.Name(v))
+	}
+	slices.Reverse(path)
+	return path
+}
@@ -0,0 +1,128 @@
+package shortest_paths
+
+import (
+	"errors"
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/Salvatore112/graph_analysis_algorithms/graphs"
+	"github.com/Salvatore112/graph_analysis_algorithms/graphs/generators"
+)
+
+type algorithm func(g graphs.Weighted, source string) (*ShortestPaths, error)
+
+func orientedGraph(edges []graphs.WeightedEdge) *graphs.WeightedOrientedGraph {
+	g := graphs.NewWeightedOrientedGraph()
+	for _, e := range edges {
+		g.AddEdge(e.U, e.V, e.Weight)
+	}
+	return g
+}
+
+func TestShortestPathsDirected(t *testing.T) {
+	g := orientedGraph([]graphs.WeightedEdge{
+		{U: "A", V: "B", Weight: 4}, {U: "A", V: "C", Weight: 1}, {U: "C", V: "B", Weight: 2},
+		{U: "B", V: "D", Weight: 1}, {U: "C", V: "D", Weight: 5}, {U: "D", V: "A", Weight: 1},
+	})
+	g.AddVertex("E")
+	for name, algo := range map[string]algorithm{"Dijkstra": Dijkstra, "BellmanFord": BellmanFord} {
+		t.Run(name, func(t *testing.T) {
+			res, err := algo(g, "A")
+			assert.NilError(t, err)
+			assert.DeepEqual(t, map[string]int{"A": 0, "B": 3, "C": 1, "D": 4}, res.Dist)
+			assert.DeepEqual(t, []string{"A", "C", "B", "D"}, res.PathTo("D"))
+			assert.DeepEqual(t, []string{"A"}, res.PathTo("A"))
+			assert.Assert(t, !res.Reachable("E"))
+			assert.Assert(t, res.PathTo("E") == nil)
+		})
+	}
+}
+
+func TestShortestPathsUndirected(t *testing.T) {
+	g := graphs.NewWeightedGraph()
+	g.AddEdge("A", "B", 1)
+	g.AddEdge("B", "C", 0)
+	g.AddEdge("C", "D", 1)
+	g.AddEdge("A", "D", 1)
+	for name, algo := range map[string]algorithm{"Dijkstra": Dijkstra, "BellmanFord": BellmanFord, "ZeroOneBFS": ZeroOneBFS} {
+		res, err := algo(g, "C")
+		assert.NilError(t, err, name)
+		assert.DeepEqual(t, map[string]int{"A": 1, "B": 0, "C": 0, "D": 1}, res.Dist)
+		assert.DeepEqual(t, []string{"C", "B", "A"}, res.PathTo("A"))
+	}
+}
+
+func TestAlgorithmsAgreeOnRandomGraphs(t *testing.T) {
+	for seed := range uint64(5) {
+		g := generators.GNM(60, 200, seed).Weighted(generators.UniformWeights(0, 1), seed)
+		expected, err := BellmanFord(g, "0")
+		assert.NilError(t, err)
+		for _, algo := range []algorithm{Dijkstra, ZeroOneBFS} {
+			res, err := algo(g, "0")
+			assert.NilError(t, err)
+			assert.DeepEqual(t, expected.Dist, res.Dist)
+			for v, d := range res.Dist {
+				// Every predecessor tree must realize the distances.
+				path, length := res.PathTo(v), 0
+				for i := 1; i < len(path); i++ {
+					w, ok := g.GetEdgeWeight(path[i-1], path[i])
+					assert.Assert(t, ok)
+					length += w
+				}
+				assert.Equal(t, d, length)
+			}
+		}
+	}
+}
+
+func TestBellmanFordNegativeWeights(t *testing.T) {
+	g := orientedGraph([]graphs.WeightedEdge{
+		{U: "S", V: "A", Weight: 4}, {U: "S", V: "B", Weight: 5}, {U: "B", V: "A", Weight: -3}, {U: "A", V: "C", Weight: 2},
+	})
+	res, err := BellmanFord(g, "S")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, map[string]int{"S": 0, "A": 2, "B": 5, "C": 4}, res.Dist)
+	assert.DeepEqual(t, []string{"S", "B", "A", "C"}, res.PathTo("C"))
+
+	_, err = Dijkstra(g, "S")
+	assert.ErrorContains(t, err, "non-negative")
+}
+
+func TestBellmanFordNegativeCycle(t *testing.T) {
+	g := orientedGraph([]graphs.WeightedEdge{
+		{U: "S", V: "A", Weight: 1}, {U: "A", V: "B", Weight: 1}, {U: "B", V: "C", Weight: -3},
+		{U: "C", V: "A", Weight: 1}, {U: "C", V: "D", Weight: 1},
+	})
+	_, err := BellmanFord(g, "S")
+	var cycleErr *NegativeCycleError
+	assert.Assert(t, errors.As(err, &cycleErr))
+	assert.Equal(t, 3, len(cycleErr.Cycle))
+
+	total := 0
+	for i, u := range cycleErr.Cycle {
+		w, ok := g.GetEdgeWeight(u, cycleErr.Cycle[(i+1)%len(cycleErr.Cycle)])
+		assert.Assert(t, ok)
+		total += w
+	}
+	assert.Equal(t, -1, total)
+
+	// The cycle is not reachable from D.
+	res, err := BellmanFord(g, "D")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, map[string]int{"D": 0}, res.Dist)
+}
+
+func TestZeroOneBFSRejectsWeights(t *testing.T) {
+	g := orientedGraph([]graphs.WeightedEdge{{U: "A", V: "B", Weight: 2}})
+	_, err := ZeroOneBFS(g, "A")
+	assert.ErrorContains(t, err, "weights 0 and 1")
+}
+
+func TestMissingSource(t *testing.T) {
+	g := orientedGraph([]graphs.WeightedEdge{{U: "A", V: "B", Weight: 1}})
+	for _, algo := range []algorithm{Dijkstra, BellmanFord, ZeroOneBFS} {
+		_, err := algo(g, "X")
+		assert.ErrorContains(t, err, "not found")
+	}
+}
@@ -0,0 +1,46 @@
+package shortest_paths
+
+import "github.com/Salvatore112/graph_analysis_algorithms/graphs"
+
+// ZeroOneBFS finds shortest paths from source in a graph whose weights are
+// all 0 or 1 in O(V + E) time.
+//
+// Vertices are processed in layers of equal distance: arcs of weight 0
+// extend the current layer and arcs of weight 1 feed the next one.
+func ZeroOneBFS(g graphs.Weighted, source string) (*ShortestPaths, error) {
+	s, src, err := newSearch(g, source)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkWeights(func(w int) bool { return w == 0 || w == 1 }, "0-1 BFS needs weights 0 and 1"); err != nil {
+		return nil, err
+	}
+
+	done := make([]bool, len(s.dist))
+	layer := []int32{src}
+	for dist := 0; len(layer) > 0; dist++ {
+		next := make([]int32, 0)
+		for i := 0; i < len(layer); i++ {
+			u := layer[i]
+			if done[u] {
+				continue
+			}
+			done[u] = true
+			lo, hi := s.g.ArcRange(u)
+			for j := lo; j < hi; j++ {
+				v, w := s.g.Target(j), s.g.ArcWeight(j)
+				if done[v] || dist+w >= s.dist[v] {
+					continue
+				}
+				s.dist[v], s.pred[v] = dist+w, u
+				if w == 0 {
+					layer = append(layer, v)
+				} else {
+					next = append(next, v)
+				}
+			}
+		}
+		layer = next
+	}
+	return s.result(src), nil
+}